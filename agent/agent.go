@@ -167,6 +167,11 @@ const (
 	AgentServiceName  = "AGENT_SERVICE_NAME"
 	MongoOplogSize    = "MONGO_OPLOG_SIZE"
 	NUMACtlPreference = "NUMA_CTL_PREFERENCE"
+
+	// LoggingOverride, if set in an agent's config, is used as the
+	// agent's logging configuration in place of the model's
+	// logging-config setting.
+	LoggingOverride = "LOGGING_OVERRIDE"
 )
 
 // The Config interface is the sole way that the agent gets access to the
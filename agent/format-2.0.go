@@ -85,6 +85,14 @@ func (formatter_2_0) unmarshal(data []byte) (*configInternal, error) {
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	if format.UpgradedToVersion == nil {
+		return nil, requiredError("upgradedToVersion")
+	}
+	if format.Values == nil {
+		// A hand-edited config may leave out the values entirely;
+		// make sure they can still be set once read.
+		format.Values = make(map[string]string)
+	}
 	config := &configInternal{
 		tag: tag,
 		paths: NewPathsWithDefaults(Paths{
@@ -49,6 +49,29 @@ func (*format_2_0Suite) TestReadConfWithExisting2_0ConfigFileContents(c *gc.C) {
 	c.Assert(config.Jobs(), jc.DeepEquals, []multiwatcher.MachineJob{multiwatcher.JobManageModel})
 }
 
+func (*format_2_0Suite) TestReadConfWithoutUpgradedToVersion(c *gc.C) {
+	_, _, err := parseConfigData([]byte(agentConfig2_0Minimal))
+	c.Assert(err, gc.ErrorMatches, "upgradedToVersion not found in configuration")
+}
+
+func (*format_2_0Suite) TestReadConfWithoutValues(c *gc.C) {
+	data := agentConfig2_0Minimal + "upgradedToVersion: 2.0.0\n"
+	_, config, err := parseConfigData([]byte(data))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(config.UpgradedToVersion(), gc.Equals, version.MustParse("2.0.0"))
+
+	// Values can be set even though none were read.
+	config.SetValue("foo", "bar")
+	c.Assert(config.Value("foo"), gc.Equals, "bar")
+}
+
+var agentConfig2_0Minimal = `
+# format 2.0
+controller: controller-deadbeef-1bad-500d-9000-4b1d0d06f00d
+model: model-deadbeef-0bad-400d-8000-4b1d0d06f00d
+tag: machine-1
+`[1:]
+
 var agentConfig2_0Contents = `
 # format 2.0
 controller: controller-deadbeef-1bad-500d-9000-4b1d0d06f00d
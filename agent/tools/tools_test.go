@@ -107,6 +107,20 @@ func (t *ToolsSuite) TestUnpackToolsBadChecksum(c *gc.C) {
 	c.Assert(err, gc.FitsTypeOf, &os.PathError{})
 }
 
+func (t *ToolsSuite) TestUnpackToolsBadSize(c *gc.C) {
+	data, checksum := testing.TarGz(testing.NewTarFile("tools", agenttools.DirPerm, "some data"))
+	testTools := &coretest.Tools{
+		URL:     "http://foo/bar",
+		Version: version.MustParseBinary("1.2.3-quantal-amd64"),
+		Size:    int64(len(data)) + 1,
+		SHA256:  checksum,
+	}
+	err := agenttools.UnpackTools(t.dataDir, testTools, bytes.NewReader(data))
+	c.Assert(err, gc.ErrorMatches, "tarball size mismatch, expected [0-9]+, got [0-9]+")
+	_, err = os.Stat(t.toolsDir())
+	c.Assert(err, gc.FitsTypeOf, &os.PathError{})
+}
+
 func (t *ToolsSuite) toolsDir() string {
 	return filepath.Join(t.dataDir, "tools")
 }
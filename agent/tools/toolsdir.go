@@ -57,9 +57,10 @@ func ToolsDir(dataDir, agentName string) string {
 // within dataDir. If a valid tools directory already exists,
 // UnpackTools returns without error.
 func UnpackTools(dataDir string, tools *coretools.Tools, r io.Reader) (err error) {
-	// Unpack the gzip file and compute the checksum.
+	// Unpack the gzip file and compute the checksum and size.
 	sha256hash := sha256.New()
-	zr, err := gzip.NewReader(io.TeeReader(r, sha256hash))
+	counter := &byteCounter{}
+	zr, err := gzip.NewReader(io.TeeReader(r, io.MultiWriter(sha256hash, counter)))
 	if err != nil {
 		return err
 	}
@@ -68,15 +69,19 @@ func UnpackTools(dataDir string, tools *coretools.Tools, r io.Reader) (err error
 	if err != nil {
 		return err
 	}
+	defer os.Remove(f.Name())
+	defer f.Close()
 	_, err = io.Copy(f, zr)
 	if err != nil {
 		return err
 	}
-	defer os.Remove(f.Name())
 	gzipSHA256 := fmt.Sprintf("%x", sha256hash.Sum(nil))
 	if tools.SHA256 != gzipSHA256 {
 		return fmt.Errorf("tarball sha256 mismatch, expected %s, got %s", tools.SHA256, gzipSHA256)
 	}
+	if tools.Size > 0 && tools.Size != counter.n {
+		return fmt.Errorf("tarball size mismatch, expected %d, got %d", tools.Size, counter.n)
+	}
 
 	// Make a temporary directory in the tools directory,
 	// first ensuring that the tools directory exists.
@@ -216,3 +221,13 @@ func ChangeAgentTools(dataDir string, agentName string, vers version.Binary) (*c
 	}
 	return tools, nil
 }
+
+// byteCounter is an io.Writer that counts the bytes written to it.
+type byteCounter struct {
+	n int64
+}
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
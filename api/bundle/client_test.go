@@ -0,0 +1,91 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundle_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/bundle"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/testing"
+)
+
+type bundleMockSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&bundleMockSuite{})
+
+func (s *bundleMockSuite) TestExportBundle(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Bundle")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "ExportBundle")
+			c.Check(a, gc.IsNil)
+			c.Assert(result, gc.FitsTypeOf, &params.StringResult{})
+			*(result.(*params.StringResult)) = params.StringResult{
+				Result: "applications: {}\n",
+			}
+			return nil
+		},
+	)
+	client := bundle.NewClient(versionedCaller{apiCaller, 2})
+	data, err := client.ExportBundle()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data, gc.Equals, "applications: {}\n")
+}
+
+func (s *bundleMockSuite) TestExportBundleError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			*(result.(*params.StringResult)) = params.StringResult{
+				Error: &params.Error{Message: "boom"},
+			}
+			return nil
+		},
+	)
+	client := bundle.NewClient(versionedCaller{apiCaller, 2})
+	_, err := client.ExportBundle()
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *bundleMockSuite) TestExportBundleNotSupported(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Fatalf("unexpected call to %s.%s", objType, request)
+			return nil
+		},
+	)
+	client := bundle.NewClient(versionedCaller{apiCaller, 1})
+	_, err := client.ExportBundle()
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, `ExportBundle\(\) \(need V2\+\) not supported`)
+}
+
+// versionedCaller is an APICallerFunc that reports the
+// given version as the best facade version.
+type versionedCaller struct {
+	basetesting.APICallerFunc
+	version int
+}
+
+func (c versionedCaller) BestFacadeVersion(facade string) int {
+	return c.version
+}
@@ -21,7 +21,7 @@ var facadeVersions = map[string]int{
 	"ApplicationScaler":            1,
 	"Backups":                      1,
 	"Block":                        2,
-	"Bundle":                       1,
+	"Bundle":                       2,
 	"CharmRevisionUpdater":         2,
 	"Charms":                       2,
 	"Cleaner":                      2,
@@ -76,7 +76,7 @@ var facadeVersions = map[string]int{
 	"Spaces":                       2,
 	"SSHClient":                    1,
 	"StatusHistory":                2,
-	"Storage":                      3,
+	"Storage":                      5,
 	"StorageProvisioner":           3,
 	"StringsWatcher":               1,
 	"Subnets":                      2,
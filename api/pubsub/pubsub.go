@@ -0,0 +1,61 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package pubsub implements the API for forwarding pubsub
+// messages to another controller.
+package pubsub
+
+import (
+	"io"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/pubsub"
+)
+
+// MessageWriter is the interface that allows sending pubsub
+// messages to the server.
+type MessageWriter interface {
+	// ForwardMessage forwards the given message to the server.
+	ForwardMessage(*pubsub.Message) error
+
+	io.Closer
+}
+
+// API provides access to the pubsub API.
+type API struct {
+	connector base.StreamConnector
+}
+
+// NewAPI creates a new client-side pubsub API.
+func NewAPI(connector base.StreamConnector) *API {
+	return &API{connector: connector}
+}
+
+// MessageWriter returns a new message writer interface value
+// which must be closed when finished with.
+func (api *API) MessageWriter() (MessageWriter, error) {
+	conn, err := api.connector.ConnectStream("/pubsub", nil)
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot connect to /pubsub")
+	}
+	return writer{conn}, nil
+}
+
+type writer struct {
+	conn base.Stream
+}
+
+func (w writer) ForwardMessage(m *pubsub.Message) error {
+	// Note: as with the logsink API, messages that are in
+	// flight when the connection dies are lost.
+	if err := w.conn.WriteJSON(m); err != nil {
+		return errors.Annotatef(err, "cannot send pubsub message")
+	}
+	return nil
+}
+
+func (w writer) Close() error {
+	return w.conn.Close()
+}
@@ -0,0 +1,118 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pubsub_test
+
+import (
+	"errors"
+	"net/url"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/base"
+	apipubsub "github.com/juju/juju/api/pubsub"
+	"github.com/juju/juju/pubsub"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type PubSubSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&PubSubSuite{})
+
+func (s *PubSubSuite) TestMessageWriter(c *gc.C) {
+	conn := &mockConnector{
+		c: c,
+	}
+	a := apipubsub.NewAPI(conn)
+	w, err := a.MessageWriter()
+	c.Assert(err, gc.IsNil)
+
+	msg := &pubsub.Message{Topic: "topic", Origin: "machine-0"}
+	err = w.ForwardMessage(msg)
+	c.Assert(err, gc.IsNil)
+
+	c.Assert(conn.written, gc.HasLen, 1)
+	c.Assert(conn.written[0], gc.Equals, msg)
+
+	err = w.Close()
+	c.Assert(err, gc.IsNil)
+	c.Assert(conn.closeCount, gc.Equals, 1)
+}
+
+func (s *PubSubSuite) TestMessageWriterConnectError(c *gc.C) {
+	conn := &mockConnector{
+		c:            c,
+		connectError: errors.New("foo"),
+	}
+	a := apipubsub.NewAPI(conn)
+	w, err := a.MessageWriter()
+	c.Assert(err, gc.ErrorMatches, "cannot connect to /pubsub: foo")
+	c.Assert(w, gc.Equals, nil)
+}
+
+func (s *PubSubSuite) TestForwardMessageError(c *gc.C) {
+	conn := &mockConnector{
+		c:          c,
+		writeError: errors.New("foo"),
+	}
+	a := apipubsub.NewAPI(conn)
+	w, err := a.MessageWriter()
+	c.Assert(err, gc.IsNil)
+
+	err = w.ForwardMessage(&pubsub.Message{})
+	c.Assert(err, gc.ErrorMatches, "cannot send pubsub message: foo")
+	c.Assert(conn.written, gc.HasLen, 0)
+}
+
+type mockConnector struct {
+	c *gc.C
+
+	connectError error
+	writeError   error
+	written      []interface{}
+
+	closeCount int
+}
+
+func (c *mockConnector) ConnectStream(path string, values url.Values) (base.Stream, error) {
+	c.c.Assert(path, gc.Equals, "/pubsub")
+	c.c.Assert(values, gc.HasLen, 0)
+	if c.connectError != nil {
+		return nil, c.connectError
+	}
+	return mockStream{c}, nil
+}
+
+type mockStream struct {
+	conn *mockConnector
+}
+
+func (s mockStream) WriteJSON(v interface{}) error {
+	if s.conn.writeError != nil {
+		return s.conn.writeError
+	}
+	s.conn.written = append(s.conn.written, v)
+	return nil
+}
+
+func (s mockStream) ReadJSON(v interface{}) error {
+	s.conn.c.Errorf("ReadJSON called unexpectedly")
+	return nil
+}
+
+func (s mockStream) Read([]byte) (int, error) {
+	s.conn.c.Errorf("Read called unexpectedly")
+	return 0, nil
+}
+
+func (s mockStream) Write([]byte) (int, error) {
+	s.conn.c.Errorf("Write called unexpectedly")
+	return 0, nil
+}
+
+func (s mockStream) Close() error {
+	s.conn.closeCount++
+	return nil
+}
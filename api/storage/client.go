@@ -147,3 +147,65 @@ func (c *Client) AddToUnit(storages []params.StorageAddParams) ([]params.ErrorRe
 	}
 	return out.Results, nil
 }
+
+// Destroy destroys the specified storage instances, detaching them
+// from any units they are attached to.
+func (c *Client) Destroy(tags []names.StorageTag) ([]params.ErrorResult, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("Destroy() (need V4+)")
+	}
+	entities := make([]params.Entity, len(tags))
+	for i, tag := range tags {
+		entities[i] = params.Entity{Tag: tag.String()}
+	}
+	out := params.ErrorResults{}
+	in := params.Entities{Entities: entities}
+	if err := c.facade.FacadeCall("Destroy", in, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return out.Results, nil
+}
+
+// Detach detaches the specified storage instances from the units
+// they are attached to, without destroying them.
+func (c *Client) Detach(tags []names.StorageTag) ([]params.ErrorResult, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("Detach() (need V4+)")
+	}
+	ids := make([]params.StorageAttachmentId, len(tags))
+	for i, tag := range tags {
+		ids[i] = params.StorageAttachmentId{StorageTag: tag.String()}
+	}
+	out := params.ErrorResults{}
+	in := params.StorageAttachmentIds{Ids: ids}
+	if err := c.facade.FacadeCall("Detach", in, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return out.Results, nil
+}
+
+// ImportVolume brings the existing cloud volume with the given
+// provider ID, in the given storage pool, under the management of
+// the model, and returns the tag of the model's new volume.
+func (c *Client) ImportVolume(pool, providerId string) (names.VolumeTag, error) {
+	if c.BestAPIVersion() < 5 {
+		return names.VolumeTag{}, errors.NotSupportedf("ImportVolume() (need V5+)")
+	}
+	args := params.BulkImportVolumeParams{
+		Volumes: []params.ImportVolumeParams{{
+			Pool:       pool,
+			ProviderId: providerId,
+		}},
+	}
+	var results params.ImportVolumeResults
+	if err := c.facade.FacadeCall("Import", args, &results); err != nil {
+		return names.VolumeTag{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return names.VolumeTag{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return names.VolumeTag{}, err
+	}
+	return names.ParseVolumeTag(results.Results[0].VolumeTag)
+}
@@ -581,3 +581,176 @@ func (s *storageMockSuite) TestAddToUnitFacadeCallError(c *gc.C) {
 	c.Assert(errors.Cause(err), gc.ErrorMatches, msg)
 	c.Assert(found, gc.HasLen, 0)
 }
+
+func (s *storageMockSuite) TestDestroy(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "Destroy")
+			c.Check(a, jc.DeepEquals, params.Entities{[]params.Entity{
+				{Tag: "storage-data-0"},
+				{Tag: "storage-data-1"},
+			}})
+			results := result.(*params.ErrorResults)
+			results.Results = []params.ErrorResult{
+				{},
+				{Error: &params.Error{Message: "boom"}},
+			}
+			return nil
+		})
+	storageClient := storage.NewClient(versionedCaller{apiCaller, 4})
+	results, err := storageClient.Destroy([]names.StorageTag{
+		names.NewStorageTag("data/0"),
+		names.NewStorageTag("data/1"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.ErrorResult{
+		{},
+		{Error: &params.Error{Message: "boom"}},
+	})
+}
+
+func (s *storageMockSuite) TestDetach(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "Detach")
+			c.Check(a, jc.DeepEquals, params.StorageAttachmentIds{[]params.StorageAttachmentId{
+				{StorageTag: "storage-data-0"},
+			}})
+			results := result.(*params.ErrorResults)
+			results.Results = []params.ErrorResult{{}}
+			return nil
+		})
+	storageClient := storage.NewClient(versionedCaller{apiCaller, 4})
+	results, err := storageClient.Detach([]names.StorageTag{names.NewStorageTag("data/0")})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.ErrorResult{{}})
+}
+
+func (s *storageMockSuite) TestDetachFacadeCallError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			return errors.New("facade failure")
+		})
+	storageClient := storage.NewClient(versionedCaller{apiCaller, 4})
+	results, err := storageClient.Detach([]names.StorageTag{names.NewStorageTag("data/0")})
+	c.Assert(errors.Cause(err), gc.ErrorMatches, "facade failure")
+	c.Assert(results, gc.HasLen, 0)
+}
+
+func (s *storageMockSuite) TestDestroyNotSupported(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Fatalf("unexpected call to %s.%s", objType, request)
+			return nil
+		})
+	storageClient := storage.NewClient(versionedCaller{apiCaller, 3})
+	results, err := storageClient.Destroy([]names.StorageTag{names.NewStorageTag("data/0")})
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, `Destroy\(\) \(need V4\+\) not supported`)
+	c.Assert(results, gc.HasLen, 0)
+}
+
+func (s *storageMockSuite) TestDetachNotSupported(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Fatalf("unexpected call to %s.%s", objType, request)
+			return nil
+		})
+	storageClient := storage.NewClient(versionedCaller{apiCaller, 3})
+	results, err := storageClient.Detach([]names.StorageTag{names.NewStorageTag("data/0")})
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, `Detach\(\) \(need V4\+\) not supported`)
+	c.Assert(results, gc.HasLen, 0)
+}
+
+func (s *storageMockSuite) TestImportVolume(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "Import")
+			c.Check(a, jc.DeepEquals, params.BulkImportVolumeParams{
+				Volumes: []params.ImportVolumeParams{{Pool: "ebs", ProviderId: "vol-123"}},
+			})
+			results := result.(*params.ImportVolumeResults)
+			results.Results = []params.ImportVolumeResult{{VolumeTag: "volume-0"}}
+			return nil
+		})
+	storageClient := storage.NewClient(versionedCaller{apiCaller, 5})
+	volumeTag, err := storageClient.ImportVolume("ebs", "vol-123")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(volumeTag, gc.Equals, names.NewVolumeTag("0"))
+}
+
+func (s *storageMockSuite) TestImportVolumeError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			results := result.(*params.ImportVolumeResults)
+			results.Results = []params.ImportVolumeResult{{
+				Error: &params.Error{Message: "boom"},
+			}}
+			return nil
+		})
+	storageClient := storage.NewClient(versionedCaller{apiCaller, 5})
+	_, err := storageClient.ImportVolume("ebs", "vol-123")
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *storageMockSuite) TestImportVolumeNotSupported(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Fatalf("unexpected call to %s.%s", objType, request)
+			return nil
+		})
+	storageClient := storage.NewClient(versionedCaller{apiCaller, 4})
+	_, err := storageClient.ImportVolume("ebs", "vol-123")
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, `ImportVolume\(\) \(need V5\+\) not supported`)
+}
+
+// versionedCaller is an APICallerFunc that reports the
+// given version as the best facade version.
+type versionedCaller struct {
+	basetesting.APICallerFunc
+	version int
+}
+
+func (c versionedCaller) BestFacadeVersion(facade string) int {
+	return c.version
+}
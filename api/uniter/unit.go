@@ -305,7 +305,7 @@ func (u *Unit) AssignedMachine() (names.MachineTag, error) {
 }
 
 // IsPrincipal returns whether the unit is deployed in its own container,
-// and can therefore have subordinate services deployed alongside it.
+// and can therefore have subordinate applications deployed alongside it.
 //
 // NOTE: This differs from state.Unit.IsPrincipal() by returning an
 // error as well, because it needs to make an API call.
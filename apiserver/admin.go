@@ -176,8 +176,13 @@ func (a *admin) login(req params.LoginRequest, loginVersion int) (params.LoginRe
 		return fail, errors.Trace(err)
 	}
 
-	if isUser && model.MigrationMode() == state.MigrationModeImporting {
-		apiRoot = restrictAll(apiRoot, errors.New("migration in progress, model is importing"))
+	if isUser {
+		switch model.MigrationMode() {
+		case state.MigrationModeImporting:
+			apiRoot = restrictAll(apiRoot, errors.New("migration in progress, model is importing"))
+		case state.MigrationModeExporting:
+			apiRoot = restrictRoot(apiRoot, migrationClientMethodsOnly)
+		}
 	}
 
 	loginResult := params.LoginResult{
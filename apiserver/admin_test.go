@@ -327,6 +327,39 @@ func (s *loginSuite) TestDelayLogins(c *gc.C) {
 	c.Logf("done")
 }
 
+func (s *loginSuite) TestLoginRateLimitConfigured(c *gc.C) {
+	machine, password := s.Factory.MakeMachineReturningPassword(
+		c, &factory.MachineParams{Nonce: "fake_nonce"})
+	cfg := defaultServerConfig(c)
+	cfg.LoginRateLimit = 2
+	info, srv := newServerWithConfig(c, s.State, cfg)
+	defer assertStop(c, srv)
+	info.Tag = machine.Tag()
+	info.Password = password
+	info.Nonce = "fake_nonce"
+	delayChan, cleanup := apiserver.DelayLogins()
+	defer cleanup()
+
+	// One more login than the configured limit is rejected.
+	errResults, wg := startNLogins(c, 3, info)
+	select {
+	case err := <-errResults:
+		c.Check(err, jc.Satisfies, params.IsCodeTryAgain)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for login to get rejected.")
+	}
+
+	// The others succeed once they are allowed to proceed.
+	for i := 0; i < 2; i++ {
+		delayChan <- struct{}{}
+	}
+	wg.Wait()
+	close(errResults)
+	for err := range errResults {
+		c.Check(err, jc.ErrorIsNil)
+	}
+}
+
 func (s *loginSuite) TestLoginRateLimited(c *gc.C) {
 	info, srv := s.newMachineAndServer(c)
 	defer assertStop(c, srv)
@@ -17,6 +17,7 @@ import (
 	"github.com/juju/loggo"
 	"github.com/juju/utils"
 	"github.com/juju/utils/clock"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/crypto/acme"
 	"golang.org/x/crypto/acme/autocert"
 	"golang.org/x/net/websocket"
@@ -29,6 +30,7 @@ import (
 	"github.com/juju/juju/apiserver/common/apihttp"
 	"github.com/juju/juju/apiserver/observer"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/pubsub"
 	"github.com/juju/juju/rpc"
 	"github.com/juju/juju/rpc/jsoncodec"
 	"github.com/juju/juju/state"
@@ -37,7 +39,7 @@ import (
 var logger = loggo.GetLogger("juju.apiserver")
 
 // loginRateLimit defines how many concurrent Login requests we will
-// accept
+// accept, unless configured otherwise.
 const loginRateLimit = 10
 
 // Server holds the server side of the API.
@@ -63,6 +65,7 @@ type Server struct {
 	certChanged       <-chan params.StateServingInfo
 	tlsConfig         *tls.Config
 	allowModelAccess  bool
+	hub               *pubsub.Hub
 
 	// mu guards the fields below it.
 	mu sync.Mutex
@@ -111,6 +114,17 @@ type ServerConfig struct {
 	// notified of key events during API requests.
 	NewObserver observer.ObserverFactory
 
+	// LoginRateLimit holds the number of concurrent Login requests
+	// the server will accept; any more are rejected with an error
+	// telling the client to try again later. If this is zero, a
+	// default limit is used.
+	LoginRateLimit int
+
+	// Hub holds the hub on which messages forwarded from other
+	// controllers are republished. If this is nil, the server does
+	// not accept forwarded messages.
+	Hub *pubsub.Hub
+
 	// StatePool only exists to support testing.
 	StatePool *state.StatePool
 }
@@ -122,10 +136,20 @@ func (c *ServerConfig) Validate() error {
 	if c.NewObserver == nil {
 		return errors.NotValidf("missing NewObserver")
 	}
+	if c.LoginRateLimit < 0 {
+		return errors.NotValidf("negative LoginRateLimit")
+	}
 
 	return nil
 }
 
+func (c *ServerConfig) loginRateLimit() int {
+	if c.LoginRateLimit == 0 {
+		return loginRateLimit
+	}
+	return c.LoginRateLimit
+}
+
 func (c *ServerConfig) pingClock() clock.Clock {
 	if c.PingClock == nil {
 		return c.Clock
@@ -173,13 +197,14 @@ func newServer(s *state.State, lis net.Listener, cfg ServerConfig) (_ *Server, e
 		tag:         cfg.Tag,
 		dataDir:     cfg.DataDir,
 		logDir:      cfg.LogDir,
-		limiter:     utils.NewLimiter(loginRateLimit),
+		limiter:     utils.NewLimiter(cfg.loginRateLimit()),
 		validator:   cfg.Validator,
 		adminAPIFactories: map[int]adminAPIFactory{
 			3: newAdminAPIV3,
 		},
 		certChanged:      cfg.CertChanged,
 		allowModelAccess: cfg.AllowModelAccess,
+		hub:              cfg.Hub,
 	}
 
 	srv.tlsConfig = srv.newTLSConfig(cfg)
@@ -264,6 +289,8 @@ func (srv *Server) Wait() error {
 func (srv *Server) run() {
 	logger.Infof("listening on %q", srv.lis.Addr())
 
+	untrackMetrics := trackServerMetrics(srv)
+	defer untrackMetrics()
 	defer func() {
 		addr := srv.lis.Addr().String() // Addr not valid after close
 		err := srv.lis.Close()
@@ -362,6 +389,9 @@ func (srv *Server) endpoints() []apihttp.Endpoint {
 	add("/model/:modeluuid/logsink", logSinkHandler)
 	add("/model/:modeluuid/logstream", logStreamHandler)
 	add("/model/:modeluuid/log", debugLogHandler)
+	if srv.hub != nil {
+		add("/model/:modeluuid/pubsub", srv.trackRequests(newPubSubHandler(strictCtxt, srv.hub)))
+	}
 
 	charmsHandler := &charmsHandler{
 		ctxt:    httpCtxt,
@@ -396,6 +426,10 @@ func (srv *Server) endpoints() []apihttp.Endpoint {
 	add("/gui-version", &guiVersionHandler{
 		ctxt: httpCtxt,
 	})
+	add("/metrics", &metricsHandler{
+		ctxt:    httpCtxt,
+		handler: prometheus.Handler(),
+	})
 
 	// For backwards compatibility we register all the old paths
 	add("/log", debugLogHandler)
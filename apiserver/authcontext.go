@@ -197,7 +197,7 @@ func (a authenticator) localUserAuth() *authentication.UserAuthenticator {
 // logins for external users. If it fails once, it will always fail.
 func (ctxt *authContext) externalMacaroonAuth() (authentication.EntityAuthenticator, error) {
 	ctxt.macaroonAuthOnce.Do(func() {
-		ctxt._macaroonAuth, ctxt._macaroonAuthError = newExternalMacaroonAuth(ctxt.st)
+		ctxt._macaroonAuth, ctxt._macaroonAuthError = newExternalMacaroonAuth(ctxt.st, ctxt.clock)
 	})
 	if ctxt._macaroonAuth == nil {
 		return nil, errors.Trace(ctxt._macaroonAuthError)
@@ -210,7 +210,7 @@ var errMacaroonAuthNotConfigured = errors.New("macaroon authentication is not co
 // newExternalMacaroonAuth returns an authenticator that can authenticate
 // macaroon-based logins for external users. This is just a helper function
 // for authCtxt.externalMacaroonAuth.
-func newExternalMacaroonAuth(st *state.State) (*authentication.ExternalMacaroonAuthenticator, error) {
+func newExternalMacaroonAuth(st *state.State, clock clock.Clock) (*authentication.ExternalMacaroonAuthenticator, error) {
 	controllerCfg, err := st.ControllerConfig()
 	if err != nil {
 		return nil, errors.Annotate(err, "cannot get model config")
@@ -247,6 +247,7 @@ func newExternalMacaroonAuth(st *state.State) (*authentication.ExternalMacaroonA
 		return nil, errors.Annotate(err, "cannot make macaroon")
 	}
 	auth.IdentityLocation = idURL
+	auth.Clock = clock
 	return &auth, nil
 }
 
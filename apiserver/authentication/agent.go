@@ -25,6 +25,11 @@ type taggedAuthenticator interface {
 // Authenticate authenticates the provided entity.
 // It takes an entityfinder and the tag used to find the entity that requires authentication.
 func (*AgentAuthenticator) Authenticate(entityFinder EntityFinder, tag names.Tag, req params.LoginRequest) (state.Entity, error) {
+	if req.Credentials == "" {
+		// An entity without a password can never log in with one, so
+		// don't bother looking it up.
+		return nil, errors.Trace(common.ErrBadCreds)
+	}
 	entity, err := entityFinder.FindEntity(tag)
 	if errors.IsNotFound(err) {
 		return nil, errors.Trace(common.ErrBadCreds)
@@ -128,6 +128,11 @@ func (s *agentAuthenticatorSuite) TestInvalidLogins(c *gc.C) {
 		nonce:        "123",
 		about:        "machine login",
 		errorMessage: "machine 0 not provisioned",
+	}, {
+		entity:       s.unit,
+		credentials:  "",
+		about:        "unit login without password",
+		errorMessage: "invalid entity name or password",
 	}, {
 		entity:       s.user,
 		credentials:  "wrong-secret",
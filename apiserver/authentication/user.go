@@ -69,7 +69,7 @@ func (u *UserAuthenticator) Authenticate(
 ) (state.Entity, error) {
 	userTag, ok := tag.(names.UserTag)
 	if !ok {
-		return nil, errors.Errorf("invalid request")
+		return nil, errors.Trace(common.ErrBadRequest)
 	}
 	if req.Credentials == "" && userTag.IsLocal() {
 		return u.authenticateMacaroons(entityFinder, userTag, req)
@@ -215,6 +215,9 @@ type ExternalMacaroonAuthenticator struct {
 	// that is used to address the is-authenticated-user
 	// third party caveat to.
 	IdentityLocation string
+
+	// Clock is used to calculate the expiry time for macaroons.
+	Clock clock.Clock
 }
 
 var _ EntityAuthenticator = (*ExternalMacaroonAuthenticator)(nil)
@@ -224,8 +227,7 @@ func (m *ExternalMacaroonAuthenticator) newDischargeRequiredError(cause error) e
 		return errors.Trace(cause)
 	}
 	mac := m.Macaroon.Clone()
-	// TODO(fwereade): 2016-03-17 lp:1558657
-	expiryTime := time.Now().Add(externalLoginExpiryTime)
+	expiryTime := m.Clock.Now().Add(externalLoginExpiryTime)
 	if err := addMacaroonTimeBeforeCaveat(m.Service, mac, expiryTime); err != nil {
 		return errors.Annotatef(err, "cannot create macaroon")
 	}
@@ -213,6 +213,39 @@ func (s *userAuthenticatorSuite) TestAuthenticateLocalLoginMacaroon(c *gc.C) {
 	})
 }
 
+func (s *userAuthenticatorSuite) TestExternalMacaroonDischargeRequired(c *gc.C) {
+	service := mockBakeryService{}
+	clock := testing.NewClock(time.Time{})
+	authenticator := &authentication.ExternalMacaroonAuthenticator{
+		Service:          &service,
+		Macaroon:         &macaroon.Macaroon{},
+		IdentityLocation: "https://identity.invalid",
+		Clock:            clock,
+	}
+
+	service.SetErrors(&bakery.VerificationError{})
+	_, err := authenticator.Authenticate(
+		authentication.EntityFinder(nil),
+		nil,
+		params.LoginRequest{},
+	)
+	c.Assert(err, gc.FitsTypeOf, &common.DischargeRequiredError{})
+
+	// The discharge macaroon expires an hour after the clock's time.
+	service.CheckCallNames(c, "CheckAny", "AddCaveat", "AddCaveat")
+	calls := service.Calls()
+	c.Assert(calls[1].Args[1], jc.DeepEquals, checkers.Caveat{
+		Condition: "time-before 0001-01-01T01:00:00Z",
+	})
+	c.Assert(calls[2].Args[1], jc.DeepEquals, checkers.NeedDeclaredCaveat(
+		checkers.Caveat{
+			Location:  "https://identity.invalid",
+			Condition: "is-authenticated-user",
+		},
+		"username",
+	))
+}
+
 type mockBakeryService struct {
 	testing.Stub
 }
@@ -311,6 +344,7 @@ func (s *macaroonAuthenticatorSuite) TestMacaroonAuthentication(c *gc.C) {
 			Service:          svc,
 			IdentityLocation: discharger.Location(),
 			Macaroon:         mac,
+			Clock:            testing.NewClock(time.Now()),
 		}
 
 		// Authenticate once to obtain the macaroon to be discharged.
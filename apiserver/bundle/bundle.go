@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/bundlechanges"
 	"github.com/juju/errors"
+	"github.com/juju/loggo"
 	"gopkg.in/juju/charm.v6-unstable"
 
 	"github.com/juju/juju/apiserver/common"
@@ -19,33 +20,53 @@ import (
 	"github.com/juju/juju/storage"
 )
 
+var logger = loggo.GetLogger("juju.apiserver.bundle")
+
 // init registers the Bundle facade.
 func init() {
-	common.RegisterStandardFacade("Bundle", 1, newFacade)
+	common.RegisterStandardFacade("Bundle", 1, newFacadeV1)
+
+	// Facade version 2 adds ExportBundle.
+	common.RegisterStandardFacade("Bundle", 2, newFacade)
 }
 
-func newFacade(_ *state.State, _ facade.Resources, auth facade.Authorizer) (Bundle, error) {
-	return NewFacade(auth)
+func newFacadeV1(st *state.State, _ facade.Resources, auth facade.Authorizer) (BundleV1, error) {
+	return NewFacade(st, auth)
+}
+
+func newFacade(st *state.State, _ facade.Resources, auth facade.Authorizer) (Bundle, error) {
+	return NewFacade(st, auth)
 }
 
 // NewFacade creates and returns a new Bundle API facade.
-func NewFacade(auth facade.Authorizer) (Bundle, error) {
+func NewFacade(st *state.State, auth facade.Authorizer) (Bundle, error) {
 	if !auth.AuthClient() {
 		return nil, common.ErrPerm
 	}
-	return &bundleAPI{}, nil
+	return &bundleAPI{st: st}, nil
 }
 
-// Bundle defines the API endpoint used to retrieve bundle changes.
-type Bundle interface {
+// BundleV1 defines the API endpoint used to retrieve bundle changes.
+type BundleV1 interface {
 	// GetChanges returns the list of changes required to deploy the given
 	// bundle data.
 	GetChanges(params.BundleChangesParams) (params.BundleChangesResults, error)
 }
 
+// Bundle defines the API endpoint used to retrieve bundle changes
+// and to export the model as a bundle.
+type Bundle interface {
+	BundleV1
+
+	// ExportBundle returns the current model serialized as bundle YAML.
+	ExportBundle() (params.StringResult, error)
+}
+
 // bundleAPI implements the Bundle interface and is the concrete implementation
 // of the API end point.
-type bundleAPI struct{}
+type bundleAPI struct {
+	st *state.State
+}
 
 // GetChanges returns the list of changes required to deploy the given bundle
 // data. The changes are sorted by requirements, so that they can be applied in
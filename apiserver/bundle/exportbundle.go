@@ -0,0 +1,207 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundle
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/charm.v6-unstable"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+// ExportBundle returns the current model serialized as bundle YAML,
+// suitable for deploying elsewhere. Charm config options left at their
+// default values are omitted.
+func (b *bundleAPI) ExportBundle() (params.StringResult, error) {
+	var result params.StringResult
+	data, err := b.bundleData()
+	if err != nil {
+		result.Error = common.ServerError(err)
+		return result, nil
+	}
+	bytes, err := yaml.Marshal(data)
+	if err != nil {
+		return result, errors.Annotate(err, "cannot marshal bundle YAML")
+	}
+	result.Result = string(bytes)
+	return result, nil
+}
+
+// bundleData builds the bundle describing the applications, machines
+// and relations in the model.
+func (b *bundleAPI) bundleData() (*charm.BundleData, error) {
+	applications, err := b.st.AllApplications()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	data := &charm.BundleData{
+		Applications: make(map[string]*charm.ApplicationSpec, len(applications)),
+		Machines:     make(map[string]*charm.MachineSpec),
+	}
+	for _, application := range applications {
+		spec, machineIds, err := b.applicationSpec(application)
+		if err != nil {
+			return nil, errors.Annotatef(err, "exporting application %q", application.Name())
+		}
+		data.Applications[application.Name()] = spec
+		for _, id := range machineIds {
+			if _, ok := data.Machines[id]; ok {
+				continue
+			}
+			machine, err := b.machineSpec(id)
+			if err != nil {
+				return nil, errors.Annotatef(err, "exporting machine %q", id)
+			}
+			data.Machines[id] = machine
+		}
+	}
+	relations, err := b.st.AllRelations()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, relation := range relations {
+		endpoints := relation.Endpoints()
+		if len(endpoints) != 2 {
+			// Peer relations are established implicitly.
+			continue
+		}
+		data.Relations = append(data.Relations, []string{
+			endpoints[0].String(),
+			endpoints[1].String(),
+		})
+	}
+	sort.Sort(relationsByEndpoint(data.Relations))
+	return data, nil
+}
+
+// applicationSpec returns the bundle specification for the given
+// application, along with the ids of the top level machines hosting
+// its units.
+func (b *bundleAPI) applicationSpec(application *state.Application) (*charm.ApplicationSpec, []string, error) {
+	curl, _ := application.CharmURL()
+	spec := &charm.ApplicationSpec{
+		Charm:  curl.String(),
+		Series: application.Series(),
+		Expose: application.IsExposed(),
+	}
+	options, err := b.nonDefaultOptions(application)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if len(options) > 0 {
+		spec.Options = options
+	}
+	if !application.IsPrincipal() {
+		// Subordinate units follow their principals, so there are
+		// neither units nor constraints to record.
+		return spec, nil, nil
+	}
+	cons, err := application.Constraints()
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	spec.Constraints = cons.String()
+
+	units, err := application.AllUnits()
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	var machineIds []string
+	for _, unit := range units {
+		spec.NumUnits++
+		machineId, err := unit.AssignedMachineId()
+		if errors.IsNotAssigned(err) {
+			continue
+		} else if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		placement, err := unitPlacement(machineId)
+		if errors.IsNotSupported(err) {
+			logger.Warningf("not exporting placement of unit %q: %v", unit.Name(), err)
+			continue
+		} else if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		spec.To = append(spec.To, placement)
+		machineIds = append(machineIds, state.TopParentId(machineId))
+	}
+	if len(spec.To) != spec.NumUnits {
+		// Bundle placement is positional; drop it rather than
+		// misplace the units that have yet to be assigned.
+		spec.To = nil
+	}
+	return spec, machineIds, nil
+}
+
+// nonDefaultOptions returns the charm config settings of the given
+// application that differ from the charm's defaults.
+func (b *bundleAPI) nonDefaultOptions(application *state.Application) (map[string]interface{}, error) {
+	ch, _, err := application.Charm()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	settings, err := application.ConfigSettings()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	charmOptions := ch.Config().Options
+	options := make(map[string]interface{})
+	for name, value := range settings {
+		if option, ok := charmOptions[name]; ok && option.Default == value {
+			continue
+		}
+		options[name] = value
+	}
+	return options, nil
+}
+
+// machineSpec returns the bundle specification for the machine with
+// the given id.
+func (b *bundleAPI) machineSpec(id string) (*charm.MachineSpec, error) {
+	machine, err := b.st.Machine(id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	cons, err := machine.Constraints()
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, errors.Trace(err)
+	}
+	return &charm.MachineSpec{
+		Series:      machine.Series(),
+		Constraints: cons.String(),
+	}, nil
+}
+
+// unitPlacement returns the bundle placement directive for a unit
+// assigned to the machine with the given id. Bundles cannot express
+// placement in nested containers, for which a NotSupported error is
+// returned.
+func unitPlacement(machineId string) (string, error) {
+	parentId := state.ParentId(machineId)
+	if parentId == "" {
+		return machineId, nil
+	}
+	if state.ParentId(parentId) != "" {
+		return "", errors.NotSupportedf("nested container placement %q", machineId)
+	}
+	return fmt.Sprintf("%s:%s", state.ContainerTypeFromId(machineId), parentId), nil
+}
+
+// relationsByEndpoint sorts relations by their endpoints.
+type relationsByEndpoint [][]string
+
+func (r relationsByEndpoint) Len() int      { return len(r) }
+func (r relationsByEndpoint) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r relationsByEndpoint) Less(i, j int) bool {
+	if r[i][0] != r[j][0] {
+		return r[i][0] < r[j][0]
+	}
+	return r[i][1] < r[j][1]
+}
@@ -0,0 +1,140 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundle_test
+
+import (
+	"strings"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/charm.v6-unstable"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/bundle"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/instance"
+	jujutesting "github.com/juju/juju/juju/testing"
+	"github.com/juju/juju/state"
+)
+
+type exportBundleSuite struct {
+	jujutesting.JujuConnSuite
+	facade bundle.Bundle
+}
+
+var _ = gc.Suite(&exportBundleSuite{})
+
+func (s *exportBundleSuite) SetUpTest(c *gc.C) {
+	s.JujuConnSuite.SetUpTest(c)
+	auth := apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("admin"),
+	}
+	facade, err := bundle.NewFacade(s.State, auth)
+	c.Assert(err, jc.ErrorIsNil)
+	s.facade = facade
+}
+
+func (s *exportBundleSuite) exportBundle(c *gc.C) *charm.BundleData {
+	result, err := s.facade.ExportBundle()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.IsNil)
+	data, err := charm.ReadBundleData(strings.NewReader(result.Result))
+	c.Assert(err, jc.ErrorIsNil)
+	return data
+}
+
+func (s *exportBundleSuite) TestExportBundleEmpty(c *gc.C) {
+	data := s.exportBundle(c)
+	c.Assert(data.Applications, gc.HasLen, 0)
+	c.Assert(data.Machines, gc.HasLen, 0)
+	c.Assert(data.Relations, gc.HasLen, 0)
+}
+
+func (s *exportBundleSuite) TestExportBundle(c *gc.C) {
+	wordpress := s.AddTestingService(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	err := wordpress.UpdateConfigSettings(charm.Settings{"blog-title": "Bloggy"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = wordpress.SetExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	unit, err := wordpress.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.AssignToNewMachine()
+	c.Assert(err, jc.ErrorIsNil)
+	machineId, err := unit.AssignedMachineId()
+	c.Assert(err, jc.ErrorIsNil)
+
+	mysql := s.AddTestingService(c, "mysql", s.AddTestingCharm(c, "mysql"))
+	_, err = mysql.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	s.AddTestingService(c, "logging", s.AddTestingCharm(c, "logging"))
+
+	for _, pair := range [][]string{{"wordpress", "mysql"}, {"logging", "wordpress"}} {
+		eps, err := s.State.InferEndpoints(pair...)
+		c.Assert(err, jc.ErrorIsNil)
+		_, err = s.State.AddRelation(eps...)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	data := s.exportBundle(c)
+	c.Assert(data.Applications, gc.HasLen, 3)
+
+	wordpressSpec := data.Applications["wordpress"]
+	c.Assert(wordpressSpec.Charm, gc.Equals, "local:quantal/wordpress-3")
+	c.Assert(wordpressSpec.NumUnits, gc.Equals, 1)
+	c.Assert(wordpressSpec.To, jc.DeepEquals, []string{machineId})
+	c.Assert(wordpressSpec.Expose, jc.IsTrue)
+	c.Assert(wordpressSpec.Options, jc.DeepEquals, map[string]interface{}{
+		"blog-title": "Bloggy",
+	})
+
+	// The mysql unit is not yet assigned, so no placement is recorded.
+	mysqlSpec := data.Applications["mysql"]
+	c.Assert(mysqlSpec.NumUnits, gc.Equals, 1)
+	c.Assert(mysqlSpec.To, gc.HasLen, 0)
+	c.Assert(mysqlSpec.Options, gc.HasLen, 0)
+
+	loggingSpec := data.Applications["logging"]
+	c.Assert(loggingSpec.NumUnits, gc.Equals, 0)
+	c.Assert(loggingSpec.Constraints, gc.Equals, "")
+
+	c.Assert(data.Machines, gc.HasLen, 1)
+	c.Assert(data.Machines[machineId].Series, gc.Equals, "quantal")
+	c.Assert(data.Relations, jc.DeepEquals, [][]string{
+		{"logging:info", "wordpress:juju-info"},
+		{"wordpress:db", "mysql:server"},
+	})
+}
+
+func (s *exportBundleSuite) TestExportBundleDefaultOptionOmitted(c *gc.C) {
+	wordpress := s.AddTestingService(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	err := wordpress.UpdateConfigSettings(charm.Settings{"blog-title": "My Title"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	data := s.exportBundle(c)
+	c.Assert(data.Applications["wordpress"].Options, gc.HasLen, 0)
+}
+
+func (s *exportBundleSuite) TestExportBundleSkipsNestedContainerPlacement(c *gc.C) {
+	wordpress := s.AddTestingService(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	unit, err := wordpress.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	template := state.MachineTemplate{
+		Series: "quantal",
+		Jobs:   []state.MachineJob{state.JobHostUnits},
+	}
+	host, err := s.State.AddMachine("quantal", state.JobHostUnits)
+	c.Assert(err, jc.ErrorIsNil)
+	container, err := s.State.AddMachineInsideMachine(template, host.Id(), instance.LXD)
+	c.Assert(err, jc.ErrorIsNil)
+	nested, err := s.State.AddMachineInsideMachine(template, container.Id(), instance.LXD)
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.AssignToMachine(nested)
+	c.Assert(err, jc.ErrorIsNil)
+
+	data := s.exportBundle(c)
+	wordpressSpec := data.Applications["wordpress"]
+	c.Assert(wordpressSpec.NumUnits, gc.Equals, 1)
+	c.Assert(wordpressSpec.To, gc.HasLen, 0)
+	c.Assert(data.Machines, gc.HasLen, 0)
+}
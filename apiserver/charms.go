@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"mime"
@@ -119,6 +120,9 @@ func (h *charmsHandler) ServeGet(w http.ResponseWriter, r *http.Request) error {
 		if errors.IsNotFound(err) {
 			return errors.Trace(err)
 		}
+		if _, ok := errors.Cause(err).(*archiveHashMismatchError); ok {
+			return errors.Trace(err)
+		}
 
 		return errors.NewBadRequest(err, "")
 	}
@@ -496,15 +500,46 @@ func (h *charmsHandler) processGet(r *http.Request, st *state.State) (
 	if err != nil {
 		return errRet(errors.Annotate(err, "cannot create charm archive file"))
 	}
-	if _, err = io.Copy(charmFile, reader); err != nil {
+	// Verify the archive against the hash recorded when it was
+	// uploaded, so a corrupted blob is never handed out to agents.
+	hash := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(charmFile, hash), reader); err != nil {
 		cleanupFile(charmFile)
 		return errRet(errors.Annotate(err, "error processing charm archive download"))
 	}
+	if got := hex.EncodeToString(hash.Sum(nil)); got != ch.BundleSha256() {
+		cleanupFile(charmFile)
+		err := &archiveHashMismatchError{
+			curl:     curl,
+			expected: ch.BundleSha256(),
+			got:      got,
+		}
+		logger.Errorf("%v", err)
+		return errRet(err)
+	}
 
 	charmFile.Close()
 	return charmFile.Name(), fileArg, serveIcon, nil
 }
 
+// archiveHashMismatchError is returned by processGet when a stored
+// charm archive does not match the SHA256 recorded when it was
+// uploaded. It means the stored blob is corrupt, which is a server
+// error rather than a bad request.
+type archiveHashMismatchError struct {
+	curl     *charm.URL
+	expected string
+	got      string
+}
+
+// Error is part of the error interface.
+func (e *archiveHashMismatchError) Error() string {
+	return fmt.Sprintf(
+		"charm archive %q SHA256 mismatch: expected %s, got %s",
+		e.curl, e.expected, e.got,
+	)
+}
+
 // sendJSONError sends a JSON-encoded error response.  Note the
 // difference from the error response sent by the sendError function -
 // the error is encoded in the Error field as a string, not an Error
@@ -13,8 +13,10 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 
 	"github.com/juju/errors"
+	"github.com/juju/loggo"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/utils"
 	gc "gopkg.in/check.v1"
@@ -559,6 +561,36 @@ func (s *charmsSuite) TestGetStarReturnsArchiveBytes(c *gc.C) {
 	s.assertGetFileResponse(c, resp, string(data), "application/zip")
 }
 
+func (s *charmsSuite) TestGetRejectsCorruptArchive(c *gc.C) {
+	ch := testcharms.Repo.CharmArchive(c.MkDir(), "dummy")
+	s.uploadRequest(c, s.charmsURI(c, "?series=quantal"), "application/zip", ch.Path)
+
+	// Replace the stored archive with different content.
+	curl := charm.MustParseURL("local:quantal/dummy-1")
+	sch, err := s.State.Charm(curl)
+	c.Assert(err, jc.ErrorIsNil)
+	storage := storage.NewStorage(s.State.ModelUUID(), s.State.MongoSession())
+	err = storage.Remove(sch.StoragePath())
+	c.Assert(err, jc.ErrorIsNil)
+	err = storage.Put(sch.StoragePath(), strings.NewReader("corrupt"), int64(len("corrupt")))
+	c.Assert(err, jc.ErrorIsNil)
+
+	tw := &loggo.TestWriter{}
+	c.Assert(loggo.RegisterWriter("charms-tester", tw), gc.IsNil)
+	defer loggo.RemoveWriter("charms-tester")
+
+	uri := s.charmsURI(c, "?url=local:quantal/dummy-1&file=*")
+	resp := s.authRequest(c, httpRequestParams{method: "GET", url: uri})
+	s.assertErrorResponse(
+		c, resp, http.StatusInternalServerError,
+		`.*charm archive "local:quantal/dummy-1" SHA256 mismatch: expected [0-9a-f]+, got [0-9a-f]+$`,
+	)
+	c.Assert(tw.Log(), jc.LogMatches, []jc.SimpleMessage{{
+		loggo.ERROR,
+		`charm archive "local:quantal/dummy-1" SHA256 mismatch: .*`,
+	}})
+}
+
 func (s *charmsSuite) TestGetAllowsTopLevelPath(c *gc.C) {
 	// Backwards compatibility check, that we can GET from charms at
 	// https://host:port/charms
@@ -118,6 +118,16 @@ var errorTransformTests = []struct {
 	code:       params.CodeNotAssigned,
 	status:     http.StatusInternalServerError,
 	helperFunc: params.IsCodeNotAssigned,
+}, {
+	err:        state.ErrStopped,
+	code:       params.CodeStopped,
+	status:     http.StatusInternalServerError,
+	helperFunc: params.IsCodeStopped,
+}, {
+	err:        params.MigrationInProgressError,
+	code:       params.CodeMigrationInProgress,
+	status:     http.StatusInternalServerError,
+	helperFunc: params.IsCodeMigrationInProgress,
 }, {
 	err:        common.ErrStoppedWatcher,
 	code:       params.CodeStopped,
@@ -236,6 +246,7 @@ func (s *errorsSuite) TestErrorTransform(c *gc.C) {
 		case params.CodeHasAssignedUnits,
 			params.CodeNoAddressSet,
 			params.CodeUpgradeInProgress,
+			params.CodeMigrationInProgress,
 			params.CodeMachineHasAttachedStorage,
 			params.CodeDischargeRequired,
 			params.CodeModelNotFound,
@@ -44,6 +44,8 @@ func UnitStatus(unit UnitStatusGetter) (agent StatusAndErr, workload StatusAndEr
 
 	agentAlive, err := unit.AgentPresence()
 	if err != nil {
+		// We don't want any presence errors affecting status.
+		logger.Debugf("error determining presence for unit %s: %v", unit.Name(), err)
 		return
 	}
 	if unit.Life() != state.Dead && !agentAlive {
@@ -81,9 +83,9 @@ func isWorkloadInstalled(workload status.StatusInfo) bool {
 		return workload.Message != status.MessageInstallingCharm
 	case status.Waiting:
 		switch workload.Message {
-		case status.MessageWaitForMachine:
-		case status.MessageInstallingAgent:
-		case status.MessageInitializingAgent:
+		case status.MessageWaitForMachine,
+			status.MessageInstallingAgent,
+			status.MessageInitializingAgent:
 			return false
 		}
 	}
@@ -106,6 +106,20 @@ func (s *UnitStatusSuite) TestCantBeLostDuringWorkloadInstall(c *gc.C) {
 	s.checkUntouched(c)
 }
 
+func (s *UnitStatusSuite) TestCantBeLostBeforeAgentStarts(c *gc.C) {
+	s.unit.presence = false
+	s.unit.status.Status = status.Waiting
+	for i, message := range []string{
+		status.MessageWaitForMachine,
+		status.MessageInstallingAgent,
+		status.MessageInitializingAgent,
+	} {
+		c.Logf("test %d: %s", i, message)
+		s.unit.status.Message = message
+		s.checkUntouched(c)
+	}
+}
+
 type fakeStatusUnit struct {
 	agentStatus    status.StatusInfo
 	agentStatusErr error
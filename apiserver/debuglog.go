@@ -58,11 +58,11 @@ func newDebugLogHandler(
 //   excludeEntity -> []string - lists entity tags to exclude from the response
 //      - as with include, it may finish with a '*'
 //   excludeModule -> []string - lists logging modules to exclude from the response
-//   limit -> uint - show *at most* this many lines
+//   maxLines -> uint - show *at most* this many lines
 //   backlog -> uint
 //      - go back this many lines from the end before starting to filter
 //      - has no meaning if 'replay' is true
-//   level -> string one of [TRACE, DEBUG, INFO, WARNING, ERROR]
+//   level -> string one of [TRACE, DEBUG, INFO, WARNING, ERROR, CRITICAL]
 //   replay -> string - one of [true, false], if true, start the file from the start
 //   noTail -> string - one of [true, false], if true, existing logs are sent back,
 //      - but the command does not wait for new ones.
@@ -193,9 +193,9 @@ func readDebugLogParams(queryMap url.Values) (*debugLogParams, error) {
 	if value := queryMap.Get("level"); value != "" {
 		var ok bool
 		level, ok := loggo.ParseLevel(value)
-		if !ok || level < loggo.TRACE || level > loggo.ERROR {
-			return nil, errors.Errorf("level value %q is not one of %q, %q, %q, %q, %q",
-				value, loggo.TRACE, loggo.DEBUG, loggo.INFO, loggo.WARNING, loggo.ERROR)
+		if !ok || level < loggo.TRACE || level > loggo.CRITICAL {
+			return nil, errors.Errorf("level value %q is not one of %q, %q, %q, %q, %q, %q",
+				value, loggo.TRACE, loggo.DEBUG, loggo.INFO, loggo.WARNING, loggo.ERROR, loggo.CRITICAL)
 		}
 		params.filterLevel = level
 	}
@@ -5,6 +5,7 @@ package apiserver
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/juju/loggo"
@@ -66,6 +67,15 @@ func (s *debugLogDBIntSuite) TestParamConversion(c *gc.C) {
 	c.Assert(called, jc.IsTrue)
 }
 
+func (s *debugLogDBIntSuite) TestReadDebugLogParamsLevel(c *gc.C) {
+	reqParams, err := readDebugLogParams(url.Values{"level": {"CRITICAL"}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(reqParams.filterLevel, gc.Equals, loggo.CRITICAL)
+
+	_, err = readDebugLogParams(url.Values{"level": {"UNSPECIFIED"}})
+	c.Assert(err, gc.ErrorMatches, `level value "UNSPECIFIED" is not one of "TRACE", "DEBUG", "INFO", "WARNING", "ERROR", "CRITICAL"`)
+}
+
 func (s *debugLogDBIntSuite) TestParamConversionReplay(c *gc.C) {
 	reqParams := &debugLogParams{
 		fromTheStart: true,
@@ -44,6 +44,16 @@ type Registry struct {
 // The Type information is used to define what methods will be exported in the
 // API, and it must exactly match the actual object returned by the factory.
 func (f *Registry) Register(name string, version int, factory Factory, facadeType reflect.Type, feature string) error {
+	switch {
+	case name == "":
+		return errors.NotValidf("empty facade name")
+	case version < 0:
+		return errors.NotValidf("facade %q version %d", name, version)
+	case factory == nil:
+		return errors.NotValidf("facade %q with nil factory", name)
+	case facadeType == nil:
+		return errors.NotValidf("facade %q with nil type", name)
+	}
 	if f.facades == nil {
 		f.facades = make(map[string]versions, 1)
 	}
@@ -34,6 +34,37 @@ func (s *RegistrySuite) TestRegister(c *gc.C) {
 	c.Check(val, gc.Equals, "myobject")
 }
 
+func (s *RegistrySuite) TestRegisterInvalid(c *gc.C) {
+	var v interface{}
+	facadeType := reflect.TypeOf(&v).Elem()
+	for i, test := range []struct {
+		name       string
+		version    int
+		factory    facade.Factory
+		facadeType reflect.Type
+		err        string
+	}{{
+		name: "", version: 0, factory: testFacade, facadeType: facadeType,
+		err: "empty facade name not valid",
+	}, {
+		name: "myfacade", version: -1, factory: testFacade, facadeType: facadeType,
+		err: `facade "myfacade" version -1 not valid`,
+	}, {
+		name: "myfacade", version: 0, factory: nil, facadeType: facadeType,
+		err: `facade "myfacade" with nil factory not valid`,
+	}, {
+		name: "myfacade", version: 0, factory: testFacade, facadeType: nil,
+		err: `facade "myfacade" with nil type not valid`,
+	}} {
+		c.Logf("test %d", i)
+		registry := &facade.Registry{}
+		err := registry.Register(test.name, test.version, test.factory, test.facadeType, "")
+		c.Check(err, jc.Satisfies, errors.IsNotValid)
+		c.Check(err, gc.ErrorMatches, test.err)
+		c.Check(registry.List(), gc.HasLen, 0)
+	}
+}
+
 func (*RegistrySuite) TestGetFactoryUnknown(c *gc.C) {
 	registry := &facade.Registry{}
 	factory, err := registry.GetFactory("name", 0)
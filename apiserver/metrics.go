@@ -0,0 +1,143 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/juju/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/observer"
+	"github.com/juju/juju/permission"
+	"github.com/juju/juju/state"
+)
+
+// metricsHandler serves the controller's Prometheus metrics to
+// controller superusers.
+type metricsHandler struct {
+	ctxt    httpContext
+	handler http.Handler
+}
+
+// ServeHTTP implements http.Handler.
+func (h *metricsHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		if err := sendError(w, errors.MethodNotAllowedf("unsupported method: %q", req.Method)); err != nil {
+			logger.Errorf("%v", err)
+		}
+		return
+	}
+	if err := h.authenticate(req); err != nil {
+		if err := sendError(w, errors.Trace(err)); err != nil {
+			logger.Errorf("%v", err)
+		}
+		return
+	}
+	h.handler.ServeHTTP(w, req)
+}
+
+// authenticate checks that the request was made by a user with
+// superuser access to the controller.
+func (h *metricsHandler) authenticate(req *http.Request) error {
+	_, entity, err := h.ctxt.stateForRequestAuthenticatedUser(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	access, err := state.ControllerAccess(h.ctxt.srv.state, entity.Tag())
+	if errors.IsNotFound(err) {
+		return common.ErrPerm
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	if access.Access != permission.SuperuserAccess {
+		return common.ErrPerm
+	}
+	return nil
+}
+
+var (
+	apiConnectionsDesc = prometheus.NewDesc(
+		"juju_apiserver_connections",
+		"Number of active API connections.",
+		nil, nil,
+	)
+	apiServersDesc = prometheus.NewDesc(
+		"juju_apiserver_servers",
+		"Number of API servers running in this process.",
+		nil, nil,
+	)
+	statePoolSizeDesc = prometheus.NewDesc(
+		"juju_apiserver_state_pool_size",
+		"Number of hosted model States cached by the API servers.",
+		nil, nil,
+	)
+)
+
+// serverCollector is a prometheus.Collector reporting on the API
+// servers running in this process. A single collector is registered
+// for the lifetime of the process, since registering one per server
+// would clash whenever a server is restarted.
+type serverCollector struct {
+	mu      sync.Mutex
+	servers map[*Server]bool
+}
+
+var (
+	metricsCollector = &serverCollector{
+		servers: make(map[*Server]bool),
+	}
+	registerMetricsOnce sync.Once
+)
+
+// Describe implements prometheus.Collector.
+func (c *serverCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- apiConnectionsDesc
+	ch <- apiServersDesc
+	ch <- statePoolSizeDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *serverCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var connections int64
+	var statePoolSize int
+	for srv := range c.servers {
+		connections += srv.ConnectionCount()
+		statePoolSize += srv.statePool.Size()
+	}
+	ch <- prometheus.MustNewConstMetric(
+		apiConnectionsDesc, prometheus.GaugeValue, float64(connections),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		apiServersDesc, prometheus.GaugeValue, float64(len(c.servers)),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		statePoolSizeDesc, prometheus.GaugeValue, float64(statePoolSize),
+	)
+}
+
+// trackServerMetrics records the given server so that its metrics are
+// collected; the returned func stops doing so.
+func trackServerMetrics(srv *Server) func() {
+	registerMetricsOnce.Do(func() {
+		if err := prometheus.Register(metricsCollector); err != nil {
+			logger.Errorf("cannot register API server metrics: %v", err)
+		}
+		if err := prometheus.Register(observer.RequestDurationCollector()); err != nil {
+			logger.Errorf("cannot register API request metrics: %v", err)
+		}
+	})
+	metricsCollector.mu.Lock()
+	metricsCollector.servers[srv] = true
+	metricsCollector.mu.Unlock()
+	return func() {
+		metricsCollector.mu.Lock()
+		delete(metricsCollector.servers, srv)
+		metricsCollector.mu.Unlock()
+	}
+}
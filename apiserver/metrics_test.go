@@ -0,0 +1,76 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+)
+
+type metricsSuite struct {
+	authHTTPSuite
+}
+
+var _ = gc.Suite(&metricsSuite{})
+
+// metricsURL returns the URL used to retrieve the controller metrics.
+func (s *metricsSuite) metricsURL(c *gc.C) string {
+	u := s.baseURL(c)
+	u.Path = "/metrics"
+	return u.String()
+}
+
+func (s *metricsSuite) assertError(c *gc.C, resp *http.Response, expCode int, expMessage string) {
+	body := assertResponse(c, resp, expCode, params.ContentTypeJSON)
+	var jsonResp params.ErrorResult
+	err := json.Unmarshal(body, &jsonResp)
+	c.Assert(err, jc.ErrorIsNil, gc.Commentf("body: %s", body))
+	c.Assert(jsonResp.Error.Message, gc.Matches, expMessage)
+}
+
+func (s *metricsSuite) TestMetricsMethodNotAllowed(c *gc.C) {
+	resp := s.authRequest(c, httpRequestParams{
+		method: "POST",
+		url:    s.metricsURL(c),
+	})
+	s.assertError(c, resp, http.StatusMethodNotAllowed, `unsupported method: "POST"`)
+}
+
+func (s *metricsSuite) TestMetricsNoCredentials(c *gc.C) {
+	resp := s.sendRequest(c, httpRequestParams{
+		method: "GET",
+		url:    s.metricsURL(c),
+	})
+	s.assertError(c, resp, http.StatusUnauthorized, "no credentials provided")
+}
+
+func (s *metricsSuite) TestMetricsRequiresSuperuser(c *gc.C) {
+	resp := s.authRequest(c, httpRequestParams{
+		method: "GET",
+		url:    s.metricsURL(c),
+	})
+	s.assertError(c, resp, http.StatusUnauthorized, "permission denied")
+}
+
+func (s *metricsSuite) TestMetrics(c *gc.C) {
+	resp := s.sendRequest(c, httpRequestParams{
+		tag:      s.AdminUserTag(c).String(),
+		password: "dummy-secret",
+		method:   "GET",
+		url:      s.metricsURL(c),
+	})
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(resp.StatusCode, gc.Equals, http.StatusOK, gc.Commentf("body: %s", body))
+	c.Assert(string(body), jc.Contains, "juju_apiserver_connections")
+	c.Assert(string(body), jc.Contains, "juju_apiserver_servers")
+	c.Assert(string(body), jc.Contains, "juju_apiserver_state_pool_size")
+}
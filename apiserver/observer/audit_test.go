@@ -0,0 +1,57 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package observer_test
+
+import (
+	"net/http"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/utils/set"
+	"github.com/juju/version"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/observer"
+	"github.com/juju/juju/audit"
+	"github.com/juju/juju/rpc"
+)
+
+type auditSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&auditSuite{})
+
+func (s *auditSuite) TestExcludedMethodsNotAudited(c *gc.C) {
+	var entries []audit.AuditEntry
+	auditObserver := observer.NewAudit(
+		&observer.AuditContext{
+			JujuServerVersion: version.MustParse("2.0.1"),
+			ModelUUID:         "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+			ExcludeMethods:    set.NewStrings("Client.FullStatus"),
+		},
+		func(entry audit.AuditEntry) error {
+			entries = append(entries, entry)
+			return nil
+		},
+		func(err error) { c.Errorf("unexpected error: %v", err) },
+	)
+	auditObserver.Join(&http.Request{RemoteAddr: "10.0.0.1:1234"}, 1)
+	auditObserver.Login(names.NewUserTag("bob"), names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00d"), false, "")
+
+	rpcObserver := auditObserver.RPCObserver()
+	rpcObserver.ServerRequest(&rpc.Header{
+		Request: rpc.Request{Type: "Client", Version: 1, Action: "FullStatus"},
+	}, nil)
+	rpcObserver.ServerRequest(&rpc.Header{
+		Request: rpc.Request{Type: "Application", Version: 1, Action: "Deploy"},
+	}, nil)
+
+	c.Assert(entries, gc.HasLen, 1)
+	c.Check(entries[0].Operation, gc.Equals, "Application:v1 - Deploy")
+	c.Check(entries[0].OriginName, gc.Equals, "user-bob")
+	c.Check(entries[0].RemoteAddress, gc.Equals, "10.0.0.1:1234")
+	c.Check(entries[0].Validate(), jc.ErrorIsNil)
+}
@@ -0,0 +1,27 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package observer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// requestDuration records how long the API server takes to serve
+// requests, by facade, facade version, method and error code.
+var requestDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: "juju",
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of Juju API requests in seconds.",
+	},
+	[]string{"facade", "version", "method", "error_code"},
+)
+
+// RequestDurationCollector returns the prometheus.Collector holding
+// the request durations recorded by RequestObservers. It must be
+// registered at most once per process.
+func RequestDurationCollector() prometheus.Collector {
+	return requestDuration
+}
@@ -4,6 +4,7 @@ package observer
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"gopkg.in/juju/names.v2"
@@ -20,6 +21,7 @@ import (
 type RequestObserver struct {
 	clock              clock.Clock
 	logger             loggo.Logger
+	slowCallThreshold  time.Duration
 	apiConnectionCount func() int64
 
 	// state represents information that's built up as methods on this
@@ -31,6 +33,7 @@ type RequestObserver struct {
 		id                 uint64
 		websocketConnected time.Time
 		tag                string
+		model              string
 	}
 }
 
@@ -43,19 +46,26 @@ type RequestObserverContext struct {
 
 	// Logger is the log to use to write log statements.
 	Logger loggo.Logger
+
+	// SlowCallThreshold is the duration after which an RPC call is
+	// logged as slow, at WARNING level. Zero disables slow call
+	// logging.
+	SlowCallThreshold time.Duration
 }
 
 // NewRequestObserver returns a new RPCObserver.
 func NewRequestObserver(ctx RequestObserverContext) *RequestObserver {
 	return &RequestObserver{
-		clock:  ctx.Clock,
-		logger: ctx.Logger,
+		clock:             ctx.Clock,
+		logger:            ctx.Logger,
+		slowCallThreshold: ctx.SlowCallThreshold,
 	}
 }
 
 // Login implements Observer.
-func (n *RequestObserver) Login(entity names.Tag, _ names.ModelTag, _ bool, _ string) {
+func (n *RequestObserver) Login(entity names.Tag, model names.ModelTag, _ bool, _ string) {
 	n.state.tag = entity.String()
+	n.state.model = model.Id()
 }
 
 // Join implements Observer.
@@ -83,20 +93,24 @@ func (n *RequestObserver) Leave() {
 // RPCObserver implements Observer.
 func (n *RequestObserver) RPCObserver() rpc.Observer {
 	return &rpcObserver{
-		clock:  n.clock,
-		logger: n.logger,
-		id:     n.state.id,
-		tag:    n.state.tag,
+		clock:             n.clock,
+		logger:            n.logger,
+		slowCallThreshold: n.slowCallThreshold,
+		id:                n.state.id,
+		tag:               n.state.tag,
+		model:             n.state.model,
 	}
 }
 
 // rpcObserver serves as a sink for RPC requests and responses.
 type rpcObserver struct {
-	clock        clock.Clock
-	logger       loggo.Logger
-	id           uint64
-	tag          string
-	requestStart time.Time
+	clock             clock.Clock
+	logger            loggo.Logger
+	slowCallThreshold time.Duration
+	id                uint64
+	tag               string
+	model             string
+	requestStart      time.Time
 }
 
 // ServerReques timplements rpc.Observer.
@@ -122,6 +136,25 @@ func (n *rpcObserver) ServerReply(req rpc.Request, hdr *rpc.Header, body interfa
 		return
 	}
 
+	duration := n.clock.Now().Sub(n.requestStart)
+	requestDuration.WithLabelValues(
+		req.Type,
+		strconv.Itoa(req.Version),
+		req.Action,
+		hdr.ErrorCode,
+	).Observe(duration.Seconds())
+	if n.slowCallThreshold > 0 && duration >= n.slowCallThreshold {
+		n.logger.Warningf(
+			"[%X] %s slow API call %s.%s in model %q took %v",
+			n.id,
+			n.tag,
+			req.Type,
+			req.Action,
+			n.model,
+			duration,
+		)
+	}
+
 	// TODO(rog) 2013-10-11 remove secrets from some responses.
 	// Until secrets are removed, we only log the body of the requests at trace level
 	// which is below the default level of debug.
@@ -132,7 +165,7 @@ func (n *rpcObserver) ServerReply(req rpc.Request, hdr *rpc.Header, body interfa
 			"-> [%X] %s %s %s %s[%q].%s",
 			n.id,
 			n.tag,
-			time.Since(n.requestStart),
+			duration,
 			jsoncodec.DumpRequest(hdr, "'body redacted'"),
 			req.Type,
 			req.Id,
@@ -0,0 +1,100 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package observer_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/prometheus/client_golang/prometheus"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/observer"
+	"github.com/juju/juju/rpc"
+)
+
+type requestObserverSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&requestObserverSuite{})
+
+func (s *requestObserverSuite) TestSlowCallLogged(c *gc.C) {
+	var tw loggo.TestWriter
+	c.Assert(loggo.RegisterWriter("slow-call-tester", &tw), gc.IsNil)
+	defer loggo.RemoveWriter("slow-call-tester")
+
+	clock := testing.NewClock(time.Now())
+	requestObserver := observer.NewRequestObserver(observer.RequestObserverContext{
+		Clock:             clock,
+		Logger:            loggo.GetLogger("juju.apiserver.observer.test"),
+		SlowCallThreshold: time.Second,
+	})
+	requestObserver.Join(&http.Request{RemoteAddr: "10.0.0.1:1234"}, 1)
+	requestObserver.Login(names.NewUserTag("bob"), names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00d"), false, "")
+
+	rpcObserver := requestObserver.RPCObserver()
+	req := rpc.Request{Type: "Client", Version: 1, Action: "FullStatus"}
+	rpcObserver.ServerRequest(&rpc.Header{Request: req}, nil)
+	clock.Advance(500 * time.Millisecond)
+	rpcObserver.ServerReply(req, &rpc.Header{}, nil)
+	for _, entry := range tw.Log() {
+		c.Check(entry.Level, gc.Not(gc.Equals), loggo.WARNING, gc.Commentf("%s", entry.Message))
+	}
+
+	rpcObserver.ServerRequest(&rpc.Header{Request: req}, nil)
+	clock.Advance(2 * time.Second)
+	rpcObserver.ServerReply(req, &rpc.Header{}, nil)
+	c.Check(tw.Log(), jc.LogMatches, jc.SimpleMessages{{
+		loggo.WARNING,
+		`\[1\] user-bob slow API call Client.FullStatus in model "deadbeef-0bad-400d-8000-4b1d0d06f00d" took 2s`,
+	}})
+}
+
+func (s *requestObserverSuite) TestRequestDurationRecorded(c *gc.C) {
+	registry := prometheus.NewRegistry()
+	c.Assert(registry.Register(observer.RequestDurationCollector()), jc.ErrorIsNil)
+
+	clock := testing.NewClock(time.Now())
+	requestObserver := observer.NewRequestObserver(observer.RequestObserverContext{
+		Clock:  clock,
+		Logger: loggo.GetLogger("juju.apiserver.observer.test"),
+	})
+	requestObserver.Join(&http.Request{RemoteAddr: "10.0.0.1:1234"}, 1)
+
+	rpcObserver := requestObserver.RPCObserver()
+	req := rpc.Request{Type: "DurationTest", Version: 2, Action: "Slow"}
+	rpcObserver.ServerRequest(&rpc.Header{Request: req}, nil)
+	clock.Advance(3 * time.Second)
+	rpcObserver.ServerReply(req, &rpc.Header{ErrorCode: "not found"}, nil)
+
+	families, err := registry.Gather()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(families, gc.HasLen, 1)
+	c.Assert(families[0].GetName(), gc.Equals, "juju_api_request_duration_seconds")
+	var found bool
+	for _, metric := range families[0].GetMetric() {
+		labels := make(map[string]string)
+		for _, label := range metric.GetLabel() {
+			labels[label.GetName()] = label.GetValue()
+		}
+		if labels["facade"] != "DurationTest" {
+			continue
+		}
+		found = true
+		c.Check(labels, jc.DeepEquals, map[string]string{
+			"facade":     "DurationTest",
+			"version":    "2",
+			"method":     "Slow",
+			"error_code": "not found",
+		})
+		c.Check(metric.GetHistogram().GetSampleCount(), gc.Equals, uint64(1))
+		c.Check(metric.GetHistogram().GetSampleSum(), gc.Equals, float64(3))
+	}
+	c.Assert(found, jc.IsTrue)
+}
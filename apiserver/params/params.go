@@ -548,6 +548,15 @@ type ContainerConfig struct {
 	AptProxy                proxy.Settings `json:"apt-proxy"`
 	AptMirror               string         `json:"apt-mirror"`
 	*UpdateBehavior
+
+	// ContainerNetworkingMethod is how containers get their network
+	// addresses: "local", "provider" or "fan". If empty, addresses
+	// are requested from the provider when possible.
+	ContainerNetworkingMethod string `json:"container-networking-method,omitempty"`
+
+	// FanConfig holds the model's fan overlays, in the form accepted
+	// by network.ParseFanConfig.
+	FanConfig string `json:"fan-config,omitempty"`
 }
 
 // ProvisioningScriptParams contains the parameters for the
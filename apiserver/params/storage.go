@@ -668,3 +668,32 @@ type StorageAddParams struct {
 type StoragesAddParams struct {
 	Storages []StorageAddParams `json:"storages"`
 }
+
+// ImportVolumeParams holds the details of an existing cloud volume
+// to bring under Juju management.
+type ImportVolumeParams struct {
+	// Pool is the name of the storage pool that the volume
+	// belongs to.
+	Pool string `json:"pool"`
+
+	// ProviderId is the provider's ID for the volume.
+	ProviderId string `json:"provider-id"`
+}
+
+// BulkImportVolumeParams holds the details of existing cloud
+// volumes to bring under Juju management.
+type BulkImportVolumeParams struct {
+	Volumes []ImportVolumeParams `json:"volumes"`
+}
+
+// ImportVolumeResult holds the result of importing a volume.
+type ImportVolumeResult struct {
+	// VolumeTag is the tag of the volume in the model.
+	VolumeTag string `json:"volume-tag,omitempty"`
+	Error     *Error `json:"error,omitempty"`
+}
+
+// ImportVolumeResults holds the results of importing volumes.
+type ImportVolumeResults struct {
+	Results []ImportVolumeResult `json:"results"`
+}
@@ -246,6 +246,13 @@ func (p *ProvisionerAPI) ContainerManagerConfig(args params.ContainerManagerConf
 		// TODO(jam): Do we want to handle ImageStream here, or do we
 		// hide it from them? (all cached images must come from the
 		// same image stream?)
+		config, err := p.st.ModelConfig()
+		if err != nil {
+			return result, err
+		}
+		if url, ok := config.ContainerImageMetadataURL(); ok {
+			cfg[container.ConfigImageMetadataURL] = url
+		}
 	}
 
 	result.ManagerConfig = cfg
@@ -271,6 +278,12 @@ func (p *ProvisionerAPI) ContainerConfig() (params.ContainerConfig, error) {
 	result.Proxy = config.ProxySettings()
 	result.AptProxy = config.AptProxySettings()
 	result.AptMirror = config.AptMirror()
+	result.ContainerNetworkingMethod = config.ContainerNetworkingMethod()
+	fanConfig, err := config.FanConfig()
+	if err != nil {
+		return result, err
+	}
+	result.FanConfig = fanConfig.String()
 
 	return result, nil
 }
@@ -1043,6 +1043,25 @@ func (s *withoutControllerSuite) TestContainerManagerConfig(c *gc.C) {
 	})
 }
 
+func (s *withoutControllerSuite) TestContainerManagerConfigImageMetadataURL(c *gc.C) {
+	err := s.State.UpdateModelConfig(map[string]interface{}{
+		"container-image-metadata-url": "https://images.example.com",
+	}, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg := s.getManagerConfig(c, instance.LXD)
+	c.Assert(cfg, jc.DeepEquals, map[string]string{
+		container.ConfigModelUUID:        coretesting.ModelTag.Id(),
+		container.ConfigImageMetadataURL: "https://images.example.com",
+	})
+
+	// Only LXD uses the container image mirror.
+	cfg = s.getManagerConfig(c, instance.KVM)
+	c.Assert(cfg, jc.DeepEquals, map[string]string{
+		container.ConfigModelUUID: coretesting.ModelTag.Id(),
+	})
+}
+
 func (s *withoutControllerSuite) TestContainerConfig(c *gc.C) {
 	attrs := map[string]interface{}{
 		"http-proxy":            "http://proxy.example.com:9000",
@@ -1064,6 +1083,22 @@ func (s *withoutControllerSuite) TestContainerConfig(c *gc.C) {
 	c.Check(results.Proxy, gc.DeepEquals, expectedProxy)
 	c.Check(results.AptProxy, gc.DeepEquals, expectedProxy)
 	c.Check(results.AptMirror, gc.DeepEquals, "http://example.mirror.com")
+	c.Check(results.ContainerNetworkingMethod, gc.Equals, "")
+	c.Check(results.FanConfig, gc.Equals, "")
+}
+
+func (s *withoutControllerSuite) TestContainerConfigFan(c *gc.C) {
+	attrs := map[string]interface{}{
+		"fan-config":                  "10.0.0.0/16=252.0.0.0/8",
+		"container-networking-method": "fan",
+	}
+	err := s.State.UpdateModelConfig(attrs, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.provisioner.ContainerConfig()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results.ContainerNetworkingMethod, gc.Equals, "fan")
+	c.Check(results.FanConfig, gc.Equals, "10.0.0.0/16=252.0.0.0/8")
 }
 
 func (s *withoutControllerSuite) TestSetSupportedContainers(c *gc.C) {
@@ -0,0 +1,111 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/juju/errors"
+	"golang.org/x/net/websocket"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/pubsub"
+	"github.com/juju/juju/state"
+)
+
+// pubsubHandler accepts messages forwarded from the other controllers
+// in an HA cluster and republishes them on the local hub.
+type pubsubHandler struct {
+	ctxt httpContext
+	hub  *pubsub.Hub
+}
+
+func newPubSubHandler(h httpContext, hub *pubsub.Hub) http.Handler {
+	return &pubsubHandler{
+		ctxt: h,
+		hub:  hub,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *pubsubHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	server := websocket.Server{
+		Handler: func(socket *websocket.Conn) {
+			defer socket.Close()
+
+			_, entity, err := h.ctxt.stateForRequestAuthenticatedAgent(req)
+			if err != nil {
+				h.sendError(socket, req, err)
+				return
+			}
+			// Only controller machines may forward messages.
+			machine, ok := entity.(*state.Machine)
+			if !ok || !machine.IsManager() {
+				h.sendError(socket, req, common.ErrPerm)
+				return
+			}
+
+			// If we get to here, no more errors to report, so we report a nil
+			// error.  This way the first line of the socket is always a json
+			// formatted simple error.
+			h.sendError(socket, req, nil)
+
+			messageCh := h.receiveMessages(socket)
+			for {
+				select {
+				case <-h.ctxt.stop():
+					return
+				case m, ok := <-messageCh:
+					if !ok {
+						return
+					}
+					if m.Origin == h.hub.Origin() {
+						// Never republish our own messages.
+						continue
+					}
+					h.hub.PublishMessage(m)
+				}
+			}
+		},
+	}
+	server.ServeHTTP(w, req)
+}
+
+func (h *pubsubHandler) receiveMessages(socket *websocket.Conn) <-chan pubsub.Message {
+	messageCh := make(chan pubsub.Message)
+
+	go func() {
+		defer close(messageCh)
+		for {
+			// Receive() blocks until data arrives but will also be
+			// unblocked when the API handler calls socket.Close as it
+			// finishes.
+			var m pubsub.Message
+			if err := websocket.JSON.Receive(socket, &m); err != nil {
+				logger.Debugf("pubsub receive error: %v", err)
+				return
+			}
+
+			select {
+			case <-h.ctxt.stop():
+				return
+			case messageCh <- m:
+			}
+		}
+	}()
+
+	return messageCh
+}
+
+// sendError sends a JSON-encoded error response.
+func (h *pubsubHandler) sendError(w io.Writer, req *http.Request, err error) {
+	if err != nil {
+		logger.Errorf("returning error from %s %s: %s", req.Method, req.URL.Path, errors.Details(err))
+	}
+	sendJSON(w, &params.ErrorResult{
+		Error: common.ServerError(err),
+	})
+}
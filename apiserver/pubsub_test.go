@@ -0,0 +1,90 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apipubsub "github.com/juju/juju/api/pubsub"
+	"github.com/juju/juju/pubsub"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type pubsubSuite struct {
+	apiserverBaseSuite
+	hub *pubsub.Hub
+}
+
+var _ = gc.Suite(&pubsubSuite{})
+
+func (s *pubsubSuite) SetUpTest(c *gc.C) {
+	s.apiserverBaseSuite.SetUpTest(c)
+	s.hub = pubsub.NewHub("machine-0")
+}
+
+func (s *pubsubSuite) TestForwardedMessagesArePublished(c *gc.C) {
+	config := s.sampleConfig(c)
+	config.Hub = s.hub
+	srv := s.newServer(c, config)
+	conn, _ := s.OpenAPIAsNewMachine(c, srv, state.JobManageModel)
+
+	messages := make(chan pubsub.Message, 2)
+	unsubscribe := s.hub.Subscribe("topic", func(m pubsub.Message) {
+		messages <- m
+	})
+	defer unsubscribe()
+
+	writer, err := apipubsub.NewAPI(conn).MessageWriter()
+	c.Assert(err, jc.ErrorIsNil)
+	defer writer.Close()
+
+	// Messages that originated on this controller are dropped.
+	err = writer.ForwardMessage(&pubsub.Message{Topic: "topic", Origin: "machine-0"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = writer.ForwardMessage(&pubsub.Message{
+		Topic:  "topic",
+		Origin: "machine-1",
+		Data:   map[string]interface{}{"key": "value"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case m := <-messages:
+		c.Assert(m, jc.DeepEquals, pubsub.Message{
+			Topic:  "topic",
+			Origin: "machine-1",
+			Data:   map[string]interface{}{"key": "value"},
+		})
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for forwarded message")
+	}
+	select {
+	case m := <-messages:
+		c.Fatalf("unexpected message %#v", m)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
+
+func (s *pubsubSuite) TestRejectsNonControllerMachines(c *gc.C) {
+	config := s.sampleConfig(c)
+	config.Hub = s.hub
+	srv := s.newServer(c, config)
+	conn, _ := s.OpenAPIAsNewMachine(c, srv, state.JobHostUnits)
+
+	writer, err := apipubsub.NewAPI(conn).MessageWriter()
+	c.Assert(err, gc.ErrorMatches, "cannot connect to /pubsub: permission denied")
+	c.Assert(writer, gc.IsNil)
+}
+
+func (s *pubsubSuite) TestNotServedWithoutHub(c *gc.C) {
+	srv := s.newServer(c, s.sampleConfig(c))
+	conn, _ := s.OpenAPIAsNewMachine(c, srv, state.JobManageModel)
+
+	_, err := apipubsub.NewAPI(conn).MessageWriter()
+	c.Assert(err, gc.ErrorMatches, "cannot connect to /pubsub: .*")
+}
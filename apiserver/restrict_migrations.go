@@ -0,0 +1,51 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"github.com/juju/utils/set"
+
+	"github.com/juju/juju/apiserver/params"
+)
+
+// migrationClientMethodsOnly blocks all client API requests that
+// might change a model while it is being exported, allowing only
+// those calls needed to observe the model.
+func migrationClientMethodsOnly(facadeName, methodName string) error {
+	if !IsMethodAllowedDuringMigration(facadeName, methodName) {
+		return params.MigrationInProgressError
+	}
+	return nil
+}
+
+// IsMethodAllowedDuringMigration returns true if the given API
+// method may be called by a client while its model is being
+// migrated.
+func IsMethodAllowedDuringMigration(facadeName, methodName string) bool {
+	methods, ok := allowedMethodsDuringMigration[facadeName]
+	if !ok {
+		return false
+	}
+	return methods.Contains(methodName)
+}
+
+// allowedMethodsDuringMigration stores the read-only api calls
+// that are not blocked while a model is being exported, as well
+// as their respective facade names.
+var allowedMethodsDuringMigration = map[string]set.Strings{
+	"Client": set.NewStrings(
+		"FullStatus",    // for "juju status"
+		"ModelInfo",     // for "juju show-model"
+		"StatusHistory", // for "juju show-status-log"
+	),
+	"SSHClient": set.NewStrings( // allow all SSH client related calls
+		"PublicAddress",
+		"PrivateAddress",
+		"PublicKeys",
+		"Proxy",
+	),
+	"Pinger": set.NewStrings(
+		"Ping",
+	),
+}
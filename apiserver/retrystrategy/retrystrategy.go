@@ -17,12 +17,24 @@ import (
 	"github.com/juju/juju/state/watcher"
 )
 
-// Right now, these are defined as constants, but the plan is to maybe make
-// them configurable in the future
+// Failed hooks are retried with a jittered exponential backoff, starting
+// at MinRetryTime and multiplying by RetryTimeFactor after each failure,
+// up to MaxRetryTime. Right now, these are defined as constants, but the
+// plan is to maybe make them configurable in the future. Only whether
+// hooks are retried at all is configurable, via the model's
+// automatically-retry-hooks setting.
 const (
-	MinRetryTime    = 5 * time.Second
-	MaxRetryTime    = 5 * time.Minute
+	// MinRetryTime is the delay before the first retry of a failed hook.
+	MinRetryTime = 5 * time.Second
+
+	// MaxRetryTime caps the delay between retries of a failed hook.
+	MaxRetryTime = 5 * time.Minute
+
+	// JitterRetryTime determines whether retry delays are randomised.
 	JitterRetryTime = true
+
+	// RetryTimeFactor is the factor by which the retry delay grows
+	// after each failed retry.
 	RetryTimeFactor = 2
 )
 
@@ -278,6 +278,18 @@ func dialWebsocket(c *gc.C, addr, path string, tlsVersion uint16) (*websocket.Co
 	return websocket.DialConfig(config)
 }
 
+func (s *serverSuite) TestNewServerNegativeLoginRateLimit(c *gc.C) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	c.Assert(err, jc.ErrorIsNil)
+	defer listener.Close()
+
+	cfg := defaultServerConfig(c)
+	cfg.LoginRateLimit = -1
+	srv, err := apiserver.NewServer(s.State, listener, cfg)
+	c.Assert(err, gc.ErrorMatches, "negative LoginRateLimit not valid")
+	c.Assert(srv, gc.IsNil)
+}
+
 func (s *serverSuite) TestMinTLSVersion(c *gc.C) {
 	loggo.GetLogger("juju.apiserver").SetLogLevel(loggo.TRACE)
 	_, srv := newServer(c, s.State)
@@ -84,8 +84,11 @@ const (
 	filesystemAttachmentsCall               = "filesystemAttachments"
 	allFilesystemsCall                      = "allFilesystems"
 	addStorageForUnitCall                   = "addStorageForUnit"
+	destroyStorageInstanceCall              = "destroyStorageInstance"
+	destroyStorageAttachmentCall            = "destroyStorageAttachment"
 	getBlockForTypeCall                     = "getBlockForType"
 	volumeAttachmentCall                    = "volumeAttachment"
+	addExistingVolumeCall                   = "addExistingVolume"
 )
 
 func (s *baseStorageSuite) constructState() *mockState {
@@ -224,11 +227,33 @@ func (s *baseStorageSuite) constructState() *mockState {
 			s.calls = append(s.calls, addStorageForUnitCall)
 			return nil
 		},
+		destroyStorageInstance: func(tag names.StorageTag) error {
+			s.calls = append(s.calls, destroyStorageInstanceCall)
+			if tag == s.storageTag {
+				return nil
+			}
+			return errors.NotFoundf("%s", names.ReadableString(tag))
+		},
+		destroyStorageAttachment: func(sTag names.StorageTag, u names.UnitTag) error {
+			s.calls = append(s.calls, destroyStorageAttachmentCall)
+			if sTag == s.storageTag && u == s.unitTag {
+				return nil
+			}
+			return errors.NotFoundf(
+				"attachment of %s to %s",
+				names.ReadableString(sTag), names.ReadableString(u),
+			)
+		},
 		getBlockForType: func(t state.BlockType) (state.Block, bool, error) {
 			s.calls = append(s.calls, getBlockForTypeCall)
 			val, found := s.blocks[t]
 			return val, found, nil
 		},
+		controllerUUID: coretesting.ControllerTag.Id(),
+		addExistingVolume: func(info state.VolumeInfo) (names.VolumeTag, error) {
+			s.calls = append(s.calls, addExistingVolumeCall)
+			return names.NewVolumeTag("0"), nil
+		},
 	}
 }
 
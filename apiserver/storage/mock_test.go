@@ -8,9 +8,11 @@ import (
 	"gopkg.in/juju/charm.v6-unstable"
 	"gopkg.in/juju/names.v2"
 
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/status"
 	jujustorage "github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/provider/dummy"
 )
 
 type mockPoolManager struct {
@@ -60,8 +62,13 @@ type mockState struct {
 	filesystemAttachments               func(filesystem names.FilesystemTag) ([]state.FilesystemAttachment, error)
 	allFilesystems                      func() ([]state.Filesystem, error)
 	addStorageForUnit                   func(u names.UnitTag, name string, cons state.StorageConstraints) error
+	destroyStorageInstance              func(names.StorageTag) error
+	destroyStorageAttachment            func(names.StorageTag, names.UnitTag) error
 	getBlockForType                     func(t state.BlockType) (state.Block, bool, error)
 	blockDevices                        func(names.MachineTag) ([]state.BlockDeviceInfo, error)
+	modelConfig                         *config.Config
+	controllerUUID                      string
+	addExistingVolume                   func(state.VolumeInfo) (names.VolumeTag, error)
 }
 
 func (st *mockState) StorageInstance(s names.StorageTag) (state.StorageInstance, error) {
@@ -156,6 +163,14 @@ func (st *mockState) AddStorageForUnit(u names.UnitTag, name string, cons state.
 	return st.addStorageForUnit(u, name, cons)
 }
 
+func (st *mockState) DestroyStorageInstance(tag names.StorageTag) error {
+	return st.destroyStorageInstance(tag)
+}
+
+func (st *mockState) DestroyStorageAttachment(s names.StorageTag, u names.UnitTag) error {
+	return st.destroyStorageAttachment(s, u)
+}
+
 func (st *mockState) GetBlockForType(t state.BlockType) (state.Block, bool, error) {
 	return st.getBlockForType(t)
 }
@@ -167,6 +182,18 @@ func (st *mockState) BlockDevices(m names.MachineTag) ([]state.BlockDeviceInfo,
 	return []state.BlockDeviceInfo{}, nil
 }
 
+func (st *mockState) ModelConfig() (*config.Config, error) {
+	return st.modelConfig, nil
+}
+
+func (st *mockState) ControllerUUID() string {
+	return st.controllerUUID
+}
+
+func (st *mockState) AddExistingVolume(info state.VolumeInfo) (names.VolumeTag, error) {
+	return st.addExistingVolume(info)
+}
+
 type mockNotifyWatcher struct {
 	state.NotifyWatcher
 	changes chan struct{}
@@ -353,3 +380,13 @@ func (b mockBlock) Type() state.BlockType {
 func (b mockBlock) Message() string {
 	return b.msg
 }
+
+type mockVolumeImporter struct {
+	*dummy.VolumeSource
+	importVolume func(volumeId string, resourceTags map[string]string) (jujustorage.VolumeInfo, error)
+}
+
+func (m mockVolumeImporter) ImportVolume(volumeId string, resourceTags map[string]string) (jujustorage.VolumeInfo, error) {
+	m.MethodCall(m, "ImportVolume", volumeId, resourceTags)
+	return m.importVolume(volumeId, resourceTags)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/stateenvirons"
 	"github.com/juju/juju/storage/poolmanager"
@@ -22,6 +23,12 @@ import (
 
 func init() {
 	common.RegisterStandardFacade("Storage", 3, newAPI)
+
+	// Facade version 4 adds Destroy and Detach.
+	common.RegisterStandardFacade("Storage", 4, newAPI)
+
+	// Facade version 5 adds Import.
+	common.RegisterStandardFacade("Storage", 5, newAPI)
 }
 
 func newAPI(
@@ -111,8 +118,23 @@ type storageAccess interface {
 	// AddStorageForUnit is required for storage add functionality.
 	AddStorageForUnit(tag names.UnitTag, name string, cons state.StorageConstraints) error
 
+	// DestroyStorageInstance is required for storage remove functionality.
+	DestroyStorageInstance(names.StorageTag) error
+
+	// DestroyStorageAttachment is required for storage detach functionality.
+	DestroyStorageAttachment(names.StorageTag, names.UnitTag) error
+
 	// GetBlockForType is required to block operations.
 	GetBlockForType(t state.BlockType) (state.Block, bool, error)
+
+	// ModelConfig is required for volume import functionality.
+	ModelConfig() (*config.Config, error)
+
+	// ControllerUUID is required for volume import functionality.
+	ControllerUUID() string
+
+	// AddExistingVolume is required for volume import functionality.
+	AddExistingVolume(state.VolumeInfo) (names.VolumeTag, error)
 }
 
 var getState = func(st *state.State) storageAccess {
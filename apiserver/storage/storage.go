@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/apiserver/common/storagecommon"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/permission"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/status"
@@ -709,3 +710,161 @@ func (a *API) AddToUnit(args params.StoragesAddParams) (params.ErrorResults, err
 	}
 	return params.ErrorResults{Results: result}, nil
 }
+
+// Destroy sets the specified storage instances to Dying, so that
+// they will be detached from their units and removed by the storage
+// provisioner. A "REMOVE" block can block this operation.
+func (a *API) Destroy(args params.Entities) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.RemoveAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	result := make([]params.ErrorResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		tag, err := names.ParseStorageTag(arg.Tag)
+		if err != nil {
+			result[i].Error = common.ServerError(err)
+			continue
+		}
+		if err := a.storage.DestroyStorageInstance(tag); err != nil {
+			result[i].Error = common.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+// Detach sets the specified storage attachments to Dying, unless they are
+// already Dying or Dead. Any associated, persistent storage will remain
+// alive. If a storage attachment ID does not specify a unit, the storage
+// instance is detached from all units it is attached to.
+// A "CHANGE" block can block this operation.
+func (a *API) Detach(args params.StorageAttachmentIds) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	result := make([]params.ErrorResult, len(args.Ids))
+	for i, id := range args.Ids {
+		if err := a.detachStorage(id); err != nil {
+			result[i].Error = common.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+func (a *API) detachStorage(id params.StorageAttachmentId) error {
+	storageTag, err := names.ParseStorageTag(id.StorageTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if id.UnitTag != "" {
+		unitTag, err := names.ParseUnitTag(id.UnitTag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return a.storage.DestroyStorageAttachment(storageTag, unitTag)
+	}
+	attachments, err := a.storage.StorageAttachments(storageTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, att := range attachments {
+		if err := a.storage.DestroyStorageAttachment(storageTag, att.Unit()); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// Import brings existing cloud volumes under the management of the
+// model, tagging them as belonging to it. The volumes are added to the
+// model detached and unbound, so they will not be destroyed unless they
+// are removed explicitly.
+// A "CHANGE" block can block this operation.
+func (a *API) Import(args params.BulkImportVolumeParams) (params.ImportVolumeResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ImportVolumeResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ImportVolumeResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.ImportVolumeResult, len(args.Volumes))
+	for i, arg := range args.Volumes {
+		volumeTag, err := a.importVolume(arg)
+		if err != nil {
+			results[i].Error = common.ServerError(err)
+			continue
+		}
+		results[i].VolumeTag = volumeTag.String()
+	}
+	return params.ImportVolumeResults{Results: results}, nil
+}
+
+func (a *API) importVolume(arg params.ImportVolumeParams) (_ names.VolumeTag, err error) {
+	defer errors.DeferredAnnotatef(&err, "importing volume %q", arg.ProviderId)
+	if arg.ProviderId == "" {
+		return names.VolumeTag{}, errors.NotValidf("empty provider ID")
+	}
+	cfg, err := a.poolManager.Get(arg.Pool)
+	if errors.IsNotFound(err) {
+		// There's no pool with the name, so see whether
+		// a provider type has been specified directly.
+		providerType := storage.ProviderType(arg.Pool)
+		if _, err1 := a.registry.StorageProvider(providerType); err1 != nil {
+			return names.VolumeTag{}, errors.Trace(err)
+		}
+		cfg, err = storage.NewConfig(arg.Pool, providerType, map[string]interface{}{})
+	}
+	if err != nil {
+		return names.VolumeTag{}, errors.Trace(err)
+	}
+	provider, err := a.registry.StorageProvider(cfg.Provider())
+	if err != nil {
+		return names.VolumeTag{}, errors.Trace(err)
+	}
+	if !provider.Supports(storage.StorageKindBlock) || provider.Scope() == storage.ScopeMachine {
+		return names.VolumeTag{}, errors.NotSupportedf("importing volumes from %q provider", cfg.Provider())
+	}
+	source, err := provider.VolumeSource(cfg)
+	if err != nil {
+		return names.VolumeTag{}, errors.Trace(err)
+	}
+	importer, ok := source.(storage.VolumeImporter)
+	if !ok {
+		return names.VolumeTag{}, errors.NotSupportedf("importing volumes from %q provider", cfg.Provider())
+	}
+
+	modelConfig, err := a.storage.ModelConfig()
+	if err != nil {
+		return names.VolumeTag{}, errors.Trace(err)
+	}
+	resourceTags := tags.ResourceTags(
+		a.storage.ModelTag(),
+		names.NewControllerTag(a.storage.ControllerUUID()),
+		modelConfig,
+	)
+	info, err := importer.ImportVolume(arg.ProviderId, resourceTags)
+	if err != nil {
+		return names.VolumeTag{}, errors.Trace(err)
+	}
+	return a.storage.AddExistingVolume(state.VolumeInfo{
+		HardwareId: info.HardwareId,
+		Size:       info.Size,
+		Pool:       arg.Pool,
+		VolumeId:   info.VolumeId,
+		Persistent: info.Persistent,
+	})
+}
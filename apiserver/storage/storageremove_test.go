@@ -0,0 +1,85 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+)
+
+type storageRemoveSuite struct {
+	baseStorageSuite
+}
+
+var _ = gc.Suite(&storageRemoveSuite{})
+
+func (s *storageRemoveSuite) TestDestroy(c *gc.C) {
+	results, err := s.api.Destroy(params.Entities{[]params.Entity{
+		{Tag: s.storageTag.String()},
+		{Tag: names.NewStorageTag("data/1").String()},
+		{Tag: "volume-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 3)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, `storage data/1 not found`)
+	c.Assert(results.Results[2].Error, gc.ErrorMatches, `"volume-0" is not a valid storage tag`)
+
+	s.assertCalls(c, []string{
+		getBlockForTypeCall, getBlockForTypeCall,
+		destroyStorageInstanceCall, destroyStorageInstanceCall,
+	})
+}
+
+func (s *storageRemoveSuite) TestDestroyBlocked(c *gc.C) {
+	s.blockRemoveObject(c, "TestDestroyBlocked")
+	_, err := s.api.Destroy(params.Entities{[]params.Entity{
+		{Tag: s.storageTag.String()},
+	}})
+	s.assertBlocked(c, err, "TestDestroyBlocked")
+}
+
+func (s *storageRemoveSuite) TestDetach(c *gc.C) {
+	results, err := s.api.Detach(params.StorageAttachmentIds{[]params.StorageAttachmentId{
+		{StorageTag: s.storageTag.String(), UnitTag: s.unitTag.String()},
+		{StorageTag: s.storageTag.String(), UnitTag: "unit-mysql-1"},
+		{StorageTag: s.storageTag.String(), UnitTag: "machine-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 3)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, `attachment of storage data/0 to unit mysql/1 not found`)
+	c.Assert(results.Results[2].Error, gc.ErrorMatches, `"machine-0" is not a valid unit tag`)
+
+	s.assertCalls(c, []string{
+		getBlockForTypeCall,
+		destroyStorageAttachmentCall, destroyStorageAttachmentCall,
+	})
+}
+
+func (s *storageRemoveSuite) TestDetachAllUnits(c *gc.C) {
+	results, err := s.api.Detach(params.StorageAttachmentIds{[]params.StorageAttachmentId{
+		{StorageTag: s.storageTag.String()},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+
+	s.assertCalls(c, []string{
+		getBlockForTypeCall,
+		storageInstanceAttachmentsCall,
+		destroyStorageAttachmentCall,
+	})
+}
+
+func (s *storageRemoveSuite) TestDetachBlocked(c *gc.C) {
+	s.blockAllChanges(c, "TestDetachBlocked")
+	_, err := s.api.Detach(params.StorageAttachmentIds{[]params.StorageAttachmentId{
+		{StorageTag: s.storageTag.String()},
+	}})
+	s.assertBlocked(c, err, "TestDetachBlocked")
+}
@@ -0,0 +1,129 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/state"
+	jujustorage "github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/provider/dummy"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type volumeImportSuite struct {
+	baseStorageSuite
+
+	provider *dummy.StorageProvider
+	importer mockVolumeImporter
+}
+
+var _ = gc.Suite(&volumeImportSuite{})
+
+func (s *volumeImportSuite) SetUpTest(c *gc.C) {
+	s.baseStorageSuite.SetUpTest(c)
+	s.state.modelTag = coretesting.ModelTag
+	s.state.modelConfig = coretesting.ModelConfig(c)
+
+	s.importer = mockVolumeImporter{
+		VolumeSource: &dummy.VolumeSource{},
+		importVolume: func(volumeId string, resourceTags map[string]string) (jujustorage.VolumeInfo, error) {
+			return jujustorage.VolumeInfo{
+				VolumeId:   volumeId,
+				HardwareId: "hw-" + volumeId,
+				Size:       1024,
+				Persistent: true,
+			}, nil
+		},
+	}
+	s.provider = &dummy.StorageProvider{
+		VolumeSourceFunc: func(*jujustorage.Config) (jujustorage.VolumeSource, error) {
+			return s.importer, nil
+		},
+	}
+	s.registry.Providers["importable"] = s.provider
+	_, err := s.poolManager.Create("imports", "importable", map[string]interface{}{})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *volumeImportSuite) TestImport(c *gc.C) {
+	var added []state.VolumeInfo
+	s.state.addExistingVolume = func(info state.VolumeInfo) (names.VolumeTag, error) {
+		s.calls = append(s.calls, addExistingVolumeCall)
+		added = append(added, info)
+		return names.NewVolumeTag("0"), nil
+	}
+	results, err := s.api.Import(params.BulkImportVolumeParams{
+		Volumes: []params.ImportVolumeParams{{Pool: "imports", ProviderId: "vol-123"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.ImportVolumeResult{{VolumeTag: "volume-0"}})
+	c.Assert(added, jc.DeepEquals, []state.VolumeInfo{{
+		HardwareId: "hw-vol-123",
+		Size:       1024,
+		Pool:       "imports",
+		VolumeId:   "vol-123",
+		Persistent: true,
+	}})
+	s.importer.CheckCallNames(c, "ImportVolume")
+	s.importer.CheckCall(c, 0, "ImportVolume", "vol-123", map[string]string{
+		tags.JujuModel:      coretesting.ModelTag.Id(),
+		tags.JujuController: coretesting.ControllerTag.Id(),
+	})
+	s.assertCalls(c, []string{getBlockForTypeCall, addExistingVolumeCall})
+}
+
+func (s *volumeImportSuite) TestImportProviderType(c *gc.C) {
+	results, err := s.api.Import(params.BulkImportVolumeParams{
+		Volumes: []params.ImportVolumeParams{{Pool: "importable", ProviderId: "vol-123"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.ImportVolumeResult{{VolumeTag: "volume-0"}})
+	s.importer.CheckCallNames(c, "ImportVolume")
+}
+
+func (s *volumeImportSuite) TestImportErrors(c *gc.C) {
+	s.registry.Providers["notimportable"] = &dummy.StorageProvider{
+		VolumeSourceFunc: func(*jujustorage.Config) (jujustorage.VolumeSource, error) {
+			return &dummy.VolumeSource{}, nil
+		},
+	}
+	s.registry.Providers["machinescoped"] = &dummy.StorageProvider{
+		StorageScope: jujustorage.ScopeMachine,
+	}
+	s.importer.importVolume = func(string, map[string]string) (jujustorage.VolumeInfo, error) {
+		return jujustorage.VolumeInfo{}, errors.New("volume is in use")
+	}
+	results, err := s.api.Import(params.BulkImportVolumeParams{
+		Volumes: []params.ImportVolumeParams{
+			{Pool: "imports", ProviderId: ""},
+			{Pool: "nope", ProviderId: "vol-123"},
+			{Pool: "notimportable", ProviderId: "vol-123"},
+			{Pool: "machinescoped", ProviderId: "vol-123"},
+			{Pool: "imports", ProviderId: "vol-123"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 5)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `importing volume "": empty provider ID not valid`)
+	c.Assert(results.Results[1].Error, gc.ErrorMatches, `importing volume "vol-123": mock pool manager: get pool nope not found`)
+	c.Assert(results.Results[2].Error, gc.ErrorMatches, `importing volume "vol-123": importing volumes from "notimportable" provider not supported`)
+	c.Assert(results.Results[3].Error, gc.ErrorMatches, `importing volume "vol-123": importing volumes from "machinescoped" provider not supported`)
+	c.Assert(results.Results[4].Error, gc.ErrorMatches, `importing volume "vol-123": volume is in use`)
+	s.assertCalls(c, []string{getBlockForTypeCall})
+}
+
+func (s *volumeImportSuite) TestImportBlocked(c *gc.C) {
+	s.blockAllChanges(c, "TestImportBlocked")
+	_, err := s.api.Import(params.BulkImportVolumeParams{
+		Volumes: []params.ImportVolumeParams{{Pool: "imports", ProviderId: "vol-123"}},
+	})
+	s.assertBlocked(c, err, "TestImportBlocked")
+	s.importer.CheckNoCalls(c)
+}
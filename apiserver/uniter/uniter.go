@@ -7,6 +7,7 @@ package uniter
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
@@ -1039,6 +1040,11 @@ func (u *UniterAPIV3) EnterScope(args params.RelationUnits) (params.ErrorResults
 	if err != nil {
 		return params.ErrorResults{}, err
 	}
+	cfg, err := u.st.ModelConfig()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+	egressSubnets := strings.Join(cfg.EgressSubnets(), ",")
 	for i, arg := range args.RelationUnits {
 		tag, err := names.ParseUnitTag(arg.Unit)
 		if err != nil {
@@ -1048,11 +1054,16 @@ func (u *UniterAPIV3) EnterScope(args params.RelationUnits) (params.ErrorResults
 		relUnit, err := u.getRelationUnit(canAccess, arg.Relation, tag)
 		if err == nil {
 			// Construct the settings, passing the unit's
-			// private address (we already know it).
+			// private address (we already know it), and the
+			// model's egress subnets so the remote units know
+			// where traffic from this unit comes from.
 			privateAddress, _ := relUnit.PrivateAddress()
 			settings := map[string]interface{}{
 				"private-address": privateAddress.Value,
 			}
+			if egressSubnets != "" {
+				settings["egress-subnets"] = egressSubnets
+			}
 			err = relUnit.EnterScope(settings)
 		}
 		result.Results[i].Error = common.ServerError(err)
@@ -130,6 +130,10 @@ type Region struct {
 	// If the cloud/region does not have a storage-specific
 	// endpoint URL, this will be empty.
 	StorageEndpoint string
+
+	// AuthTypes are the authentication modes supported by the
+	// region. If empty, the region supports the cloud's auth types.
+	AuthTypes AuthTypes
 }
 
 // cloudSet contains cloud definitions, used for marshalling and
@@ -169,9 +173,10 @@ type regions struct {
 
 // region is equivalent to Region, for marshalling and unmarshalling.
 type region struct {
-	Endpoint         string `yaml:"endpoint,omitempty"`
-	IdentityEndpoint string `yaml:"identity-endpoint,omitempty"`
-	StorageEndpoint  string `yaml:"storage-endpoint,omitempty"`
+	Endpoint         string     `yaml:"endpoint,omitempty"`
+	IdentityEndpoint string     `yaml:"identity-endpoint,omitempty"`
+	StorageEndpoint  string     `yaml:"storage-endpoint,omitempty"`
+	AuthTypes        []AuthType `yaml:"auth-types,omitempty,flow"`
 }
 
 //DefaultLXD is the name of the default lxd cloud.
@@ -190,28 +195,56 @@ var BuiltInClouds = map[string]Cloud{
 // CloudByName returns the cloud with the specified name.
 // If there exists no cloud with the specified name, an
 // error satisfying errors.IsNotFound will be returned.
-//
-// TODO(axw) write unit tests for this.
 func CloudByName(name string) (*Cloud, error) {
-	// Personal clouds take precedence.
-	personalClouds, err := PersonalCloudMetadata()
+	clouds, err := AllCloudMetadata()
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	if cloud, ok := personalClouds[name]; ok {
+	if cloud, ok := clouds[name]; ok {
 		return &cloud, nil
 	}
-	clouds, _, err := PublicCloudMetadata(JujuPublicCloudsPath())
+	return nil, errors.NotFoundf("cloud %s", name)
+}
+
+// AllCloudMetadata returns the definitions of all known clouds, keyed
+// on cloud name: the built-in clouds, the public clouds, and the
+// personal clouds defined by the user. Where a cloud is defined in more
+// than one place, personal clouds take precedence over public clouds,
+// which take precedence over built-in clouds.
+func AllCloudMetadata() (map[string]Cloud, error) {
+	publicClouds, _, err := PublicCloudMetadata(JujuPublicCloudsPath())
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	if cloud, ok := clouds[name]; ok {
-		return &cloud, nil
+	personalClouds, err := PersonalCloudMetadata()
+	if err != nil {
+		return nil, errors.Trace(err)
 	}
-	if cloud, ok := BuiltInClouds[name]; ok {
-		return &cloud, nil
+	clouds := make(map[string]Cloud)
+	for _, source := range []map[string]Cloud{
+		BuiltInClouds, publicClouds, personalClouds,
+	} {
+		for name, cloud := range source {
+			clouds[name] = cloud
+		}
 	}
-	return nil, errors.NotFoundf("cloud %s", name)
+	return clouds, nil
+}
+
+// RegionAuthTypes returns the authentication modes supported by the
+// cloud region with the given name. If the region does not specify
+// its own auth types, those of the cloud are returned. If the cloud
+// has no such region, an error satisfying errors.IsNotFound will be
+// returned.
+func (cloud Cloud) RegionAuthTypes(regionName string) (AuthTypes, error) {
+	region, err := RegionByName(cloud.Regions, regionName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(region.AuthTypes) > 0 {
+		return region.AuthTypes, nil
+	}
+	return cloud.AuthTypes, nil
 }
 
 // RegionByName finds the region in the given slice with the
@@ -364,6 +397,7 @@ func cloudToInternal(in Cloud) *cloud {
 				r.Endpoint,
 				r.IdentityEndpoint,
 				r.StorageEndpoint,
+				r.AuthTypes,
 			},
 		})
 	}
@@ -395,6 +429,7 @@ func cloudFromInternal(in *cloud) Cloud {
 					r.Endpoint,
 					r.IdentityEndpoint,
 					r.StorageEndpoint,
+					r.AuthTypes,
 				})
 			}
 		}
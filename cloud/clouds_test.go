@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
@@ -243,3 +244,91 @@ func (s *cloudSuite) TestRegionNames(c *gc.C) {
 	c.Assert(cloud.RegionNames([]cloud.Region{}), gc.HasLen, 0)
 	c.Assert(cloud.RegionNames(nil), gc.HasLen, 0)
 }
+
+func (s *cloudSuite) TestRegionAuthTypes(c *gc.C) {
+	metadata := `
+clouds:
+  homestack:
+    type: openstack
+    auth-types: [ userpass, access-key ]
+    regions:
+      london:
+        endpoint: http://london/1.0
+      paris:
+        endpoint: http://paris/1.0
+        auth-types: [ userpass ]
+`[1:]
+	clouds, err := cloud.ParseCloudMetadata([]byte(metadata))
+	c.Assert(err, jc.ErrorIsNil)
+	homestack := clouds["homestack"]
+	c.Assert(homestack.Regions, jc.DeepEquals, []cloud.Region{{
+		Name:     "london",
+		Endpoint: "http://london/1.0",
+	}, {
+		Name:      "paris",
+		Endpoint:  "http://paris/1.0",
+		AuthTypes: []cloud.AuthType{"userpass"},
+	}})
+
+	authTypes, err := homestack.RegionAuthTypes("london")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(authTypes, jc.DeepEquals, cloud.AuthTypes{"userpass", "access-key"})
+
+	authTypes, err = homestack.RegionAuthTypes("paris")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(authTypes, jc.DeepEquals, cloud.AuthTypes{"userpass"})
+
+	_, err = homestack.RegionAuthTypes("berlin")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *cloudSuite) TestMarshalCloudRegionAuthTypes(c *gc.C) {
+	in := cloud.Cloud{
+		Type:      "openstack",
+		AuthTypes: []cloud.AuthType{"userpass", "access-key"},
+		Regions: []cloud.Region{{
+			Name:      "paris",
+			Endpoint:  "http://paris/1.0",
+			AuthTypes: []cloud.AuthType{"userpass"},
+		}},
+	}
+	data, err := cloud.MarshalCloud(in)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), jc.Contains, "auth-types: [userpass]\n")
+	out, err := cloud.UnmarshalCloud(data)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(out, jc.DeepEquals, in)
+}
+
+func (s *cloudSuite) TestAllCloudMetadata(c *gc.C) {
+	err := cloud.WritePersonalCloudMetadata(map[string]cloud.Cloud{
+		"aws": cloud.Cloud{
+			Type:      "ec2",
+			AuthTypes: []cloud.AuthType{"access-key"},
+			Endpoint:  "http://private-aws",
+		},
+		"homestack": cloud.Cloud{
+			Type:      "openstack",
+			AuthTypes: []cloud.AuthType{"userpass"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	clouds, err := cloud.AllCloudMetadata()
+	c.Assert(err, jc.ErrorIsNil)
+	var names []string
+	for name := range clouds {
+		names = append(names, name)
+	}
+	c.Assert(names, jc.SameContents, append(publicCloudNames, "localhost", "homestack"))
+
+	// Personal clouds take precedence over public clouds.
+	c.Assert(clouds["aws"].Endpoint, gc.Equals, "http://private-aws")
+
+	homestack, err := cloud.CloudByName("homestack")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(homestack.Type, gc.Equals, "openstack")
+
+	_, err = cloud.CloudByName("nowhere")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
@@ -124,6 +124,9 @@ func GenerateNetworkConfig(networkConfig *container.NetworkConfig) (string, erro
 				gatewayHandled = true // write it only once
 			}
 		}
+		if mtu, ok := prepared.NameToMTU[name]; ok {
+			output.WriteString(fmt.Sprintf("  mtu %d\n", mtu))
+		}
 	}
 
 	generatedConfig := output.String()
@@ -144,6 +147,7 @@ type PreparedConfig struct {
 	DNSServers       []string
 	DNSSearchDomains []string
 	NameToAddress    map[string]string
+	NameToMTU        map[string]int
 	GatewayAddress   string
 }
 
@@ -156,6 +160,7 @@ func PrepareNetworkConfigFromInterfaces(interfaces []network.InterfaceInfo) *Pre
 	gatewayAddress := ""
 	namesInOrder := make([]string, 1, len(interfaces)+1)
 	nameToAddress := make(map[string]string)
+	nameToMTU := make(map[string]int)
 
 	// Always include the loopback.
 	namesInOrder[0] = "lo"
@@ -172,6 +177,10 @@ func PrepareNetworkConfigFromInterfaces(interfaces []network.InterfaceInfo) *Pre
 			nameToAddress[info.InterfaceName] = string(network.ConfigDHCP)
 		}
 
+		if info.MTU > 0 {
+			nameToMTU[info.InterfaceName] = info.MTU
+		}
+
 		for _, dns := range info.DNSServers {
 			dnsServers.Add(dns.Value)
 		}
@@ -188,6 +197,7 @@ func PrepareNetworkConfigFromInterfaces(interfaces []network.InterfaceInfo) *Pre
 	prepared := &PreparedConfig{
 		InterfaceNames:   namesInOrder,
 		NameToAddress:    nameToAddress,
+		NameToMTU:        nameToMTU,
 		AutoStarted:      autoStarted.SortedValues(),
 		DNSServers:       dnsServers.SortedValues(),
 		DNSSearchDomains: dnsSearchDomains.SortedValues(),
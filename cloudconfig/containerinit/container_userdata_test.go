@@ -192,6 +192,28 @@ func (s *UserDataSuite) TestGenerateNetworkConfig(c *gc.C) {
 	c.Assert(data, gc.Equals, s.expectedSampleConfig)
 }
 
+func (s *UserDataSuite) TestGenerateNetworkConfigWithMTU(c *gc.C) {
+	interfaces := []network.InterfaceInfo{{
+		InterfaceName: "any0",
+		CIDR:          "0.1.2.0/24",
+		ConfigType:    network.ConfigStatic,
+		Address:       network.NewAddress("0.1.2.3"),
+		MTU:           9000,
+	}}
+	netConfig := container.BridgeNetworkConfig("foo", 0, interfaces)
+	data, err := containerinit.GenerateNetworkConfig(netConfig)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data, gc.Equals, `
+auto any0 lo
+
+iface lo inet loopback
+
+iface any0 inet static
+  address 0.1.2.3/24
+  mtu 9000
+`)
+}
+
 func (s *UserDataSuite) TestNewCloudInitConfigWithNetworksSampleConfig(c *gc.C) {
 	netConfig := container.BridgeNetworkConfig("foo", 0, s.fakeInterfaces)
 	cloudConf, err := containerinit.NewCloudInitConfigWithNetworks("quantal", netConfig)
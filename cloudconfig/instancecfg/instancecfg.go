@@ -148,6 +148,10 @@ type InstanceConfig struct {
 	// instances. If enabled, the OS will perform any upgrades
 	// available as part of its provisioning.
 	EnableOSUpgrade bool
+
+	// LogFormat is the format the machine agent writes its log file
+	// in: "text", "json" or "" for the default.
+	LogFormat string
 }
 
 // ControllerConfig represents controller-specific initialization information
@@ -341,11 +345,16 @@ func (p *StateInitializationParams) Unmarshal(data []byte) error {
 }
 
 func (cfg *InstanceConfig) agentInfo() service.AgentInfo {
-	return service.NewMachineAgentInfo(
+	info := service.NewMachineAgentInfo(
 		cfg.MachineId,
 		cfg.DataDir,
 		cfg.LogDir,
 	)
+	info.LogFormat = cfg.LogFormat
+	if cfg.APIInfo != nil {
+		info.LogLabels = map[string]string{"model": cfg.APIInfo.ModelTag.Id()}
+	}
+	return info
 }
 
 func (cfg *InstanceConfig) ToolsDir(renderer shell.Renderer) string {
@@ -776,6 +785,7 @@ func FinishInstanceConfig(icfg *InstanceConfig, cfg *config.Config) (err error)
 	); err != nil {
 		return errors.Trace(err)
 	}
+	icfg.LogFormat = cfg.LoggingFormat()
 	if icfg.Controller != nil {
 		// Add NUMACTL preference. Needed to work for both bootstrap and high availability
 		// Only makes sense for controller
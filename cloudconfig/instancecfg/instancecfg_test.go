@@ -111,3 +111,11 @@ func (*instancecfgSuite) TestGUITools(c *gc.C) {
 	}
 	c.Assert(icfg.GUITools(), gc.Equals, "/path/to/datadir/gui")
 }
+
+func (*instancecfgSuite) TestFinishInstanceConfigLogFormat(c *gc.C) {
+	cfg := testing.CustomModelConfig(c, testing.Attrs{"logging-format": "json"})
+	icfg := &instancecfg.InstanceConfig{}
+	err := instancecfg.FinishInstanceConfig(icfg, cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(icfg.LogFormat, gc.Equals, "json")
+}
@@ -136,6 +136,9 @@ func (c *addUnitCommand) SetFlags(f *gnuflag.FlagSet) {
 func (c *addUnitCommand) Init(args []string) error {
 	switch len(args) {
 	case 1:
+		if !names.IsValidApplication(args[0]) {
+			return errors.Errorf("invalid application name %q", args[0])
+		}
 		c.ApplicationName = args[0]
 	case 0:
 		return errors.New("no application specified")
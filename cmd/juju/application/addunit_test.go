@@ -84,6 +84,9 @@ var initAddUnitErrorTests = []struct {
 	}, {
 		args: []string{"some-application-name", "--to", "1,#:foo"},
 		err:  `invalid --to parameter "#:foo"`,
+	}, {
+		args: []string{"some-application-name/0"},
+		err:  `invalid application name "some-application-name/0"`,
 	},
 }
 
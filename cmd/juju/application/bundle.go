@@ -569,7 +569,7 @@ func (h *bundleHandler) addUnit(id string, p bundlechanges.AddUnitParams) error
 		// This way we avoid waiting for watcher updates.
 		h.results[id] = unit
 	} else {
-		logger.Debugf("added %s unit to new machine", unit)
+		logger.Debugf("added %s unit to machine %s", unit, machineSpec)
 		h.results[id] = machineSpec
 	}
 	// Note that the machineSpec can be empty for now, resulting in a partially
@@ -53,6 +53,8 @@ func (s *BundleDeployCharmStoreSuite) TestDeployBundleInvalidFlags(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "Flags provided but not supported when deploying a bundle: -n.")
 	_, err = runDeployCommand(c, "bundle/wordpress-simple", "--series", "xenial", "--force")
 	c.Assert(err, gc.ErrorMatches, "Flags provided but not supported when deploying a bundle: --force, --series.")
+	_, err = runDeployCommand(c, "bundle/wordpress-simple", "blog")
+	c.Assert(err, gc.ErrorMatches, `Application name "blog" provided but not supported when deploying a bundle.`)
 }
 
 func (s *BundleDeployCharmStoreSuite) TestDeployBundleSuccess(c *gc.C) {
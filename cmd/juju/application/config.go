@@ -200,6 +200,9 @@ func (c *configCommand) parseResetKeys() error {
 		resetKeys = append(resetKeys, keys...)
 	}
 	for _, k := range resetKeys {
+		if k == "" {
+			return errors.New("--reset does not accept empty keys")
+		}
 		if strings.Contains(k, "=") {
 			return errors.Errorf(
 				`--reset accepts a comma delimited set of keys "a,b,c", received: %q`, k)
@@ -301,7 +304,9 @@ func (c *configCommand) setConfigFromFile(client configCommandAPI, ctx *cmd.Cont
 	)
 	if c.configFile.Path == "-" {
 		buf := bytes.Buffer{}
-		buf.ReadFrom(ctx.Stdin)
+		if _, err := buf.ReadFrom(ctx.Stdin); err != nil {
+			return errors.Annotate(err, "reading config from stdin")
+		}
 		b = buf.Bytes()
 	} else {
 		b, err = c.configFile.Read(ctx)
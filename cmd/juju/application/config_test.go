@@ -178,6 +178,12 @@ func (s *configCommandSuite) TestSetCommandInit(c *gc.C) {
 	err = coretesting.InitCommand(application.NewConfigCommandForTest(s.fake), []string{"application", "--reset", "reset,bad=key"})
 	c.Assert(err, gc.ErrorMatches, `--reset accepts a comma delimited set of keys "a,b,c", received: "bad=key"`)
 
+	// empty reset keys
+	err = coretesting.InitCommand(application.NewConfigCommandForTest(s.fake), []string{"application", "--reset", "reset,,other"})
+	c.Assert(err, gc.ErrorMatches, "--reset does not accept empty keys")
+	err = coretesting.InitCommand(application.NewConfigCommandForTest(s.fake), []string{"application", "--reset", ","})
+	c.Assert(err, gc.ErrorMatches, "--reset does not accept empty keys")
+
 	// init too many args fails
 	err = coretesting.InitCommand(application.NewConfigCommandForTest(s.fake), []string{"application", "key", "another"})
 	c.Assert(err, gc.ErrorMatches, "can only retrieve a single value, or all values")
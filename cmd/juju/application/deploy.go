@@ -427,7 +427,7 @@ func (c *DeployCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.UnitCommandBase.SetFlags(f)
 	c.ModelCommandBase.SetFlags(f)
 	f.IntVar(&c.NumUnits, "n", 1, "Number of application units to deploy for principal charms")
-	f.StringVar((*string)(&c.Channel), "channel", "", "Channel to use when getting the charm or bundle from the charm store")
+	f.Var(channelFlag{&c.Channel}, "channel", "Channel to use when getting the charm or bundle from the charm store")
 	f.Var(&c.Config, "config", "Path to yaml-formatted application config")
 	f.StringVar(&c.ConstraintsStr, "constraints", "", "Set application constraints")
 	f.StringVar(&c.Series, "series", "", "The series on which to deploy")
@@ -643,6 +643,12 @@ func (c *DeployCommand) parseBind() error {
 		if !names.IsValidSpace(space) {
 			return errors.New(parseBindErrorPrefix + "Space name invalid.")
 		}
+		if _, ok := bindings[endpoint]; ok {
+			if endpoint == "" {
+				return errors.New(parseBindErrorPrefix + "Found multiple default spaces.")
+			}
+			return errors.Errorf(parseBindErrorPrefix+"Found multiple bindings for endpoint %q.", endpoint)
+		}
 		bindings[endpoint] = space
 	}
 	c.Bindings = bindings
@@ -689,6 +695,10 @@ func findDeployerFIFO(maybeDeployers ...func() (deployFn, error)) (deployFn, err
 type deployFn func(*cmd.Context, DeployAPI) error
 
 func (c *DeployCommand) validateBundleFlags() error {
+	if c.ApplicationName != "" {
+		// Application names are taken from the bundle itself.
+		return errors.Errorf("Application name %q provided but not supported when deploying a bundle.", c.ApplicationName)
+	}
 	if flags := getFlags(c.flagSet, charmOnlyFlags); len(flags) > 0 {
 		return errors.Errorf("Flags provided but not supported when deploying a bundle: %s.", strings.Join(flags, ", "))
 	}
@@ -94,6 +94,9 @@ var initErrorTests = []struct {
 	}, {
 		args: []string{"charm", "application", "--force"},
 		err:  `--force is only used with --series`,
+	}, {
+		args: []string{"charm", "--channel", "stabel"},
+		err:  `invalid value "stabel" for flag --channel: unknown channel "stabel", .*`,
 	},
 }
 
@@ -1213,6 +1216,14 @@ func (s *ParseBindSuite) TestParseFailsWithTooManyEqualsSignsInArgs(c *gc.C) {
 	s.checkParseFailsForArgs(c, "foo=bar=baz", "Found multiple = in binding. Did you forget to space-separate the binding list?")
 }
 
+func (s *ParseBindSuite) TestParseFailsWithDuplicateEndpoint(c *gc.C) {
+	s.checkParseFailsForArgs(c, "db=sp1 db=sp2", `Found multiple bindings for endpoint "db".`)
+}
+
+func (s *ParseBindSuite) TestParseFailsWithMultipleDefaultSpaces(c *gc.C) {
+	s.checkParseFailsForArgs(c, "sp1 ep1=sp2 sp3", "Found multiple default spaces.")
+}
+
 func (s *ParseBindSuite) TestParseFailsWithBadSpaceName(c *gc.C) {
 	s.checkParseFailsForArgs(c, "rel1=spa#ce1", "Space name invalid.")
 }
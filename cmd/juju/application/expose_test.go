@@ -59,6 +59,11 @@ func (s *ExposeSuite) TestExpose(c *gc.C) {
 	})
 }
 
+func (s *ExposeSuite) TestExposeInvalidApplicationName(c *gc.C) {
+	err := runExpose(c, "wordpress/0")
+	c.Assert(err, gc.ErrorMatches, `invalid application name "wordpress/0"`)
+}
+
 func (s *ExposeSuite) TestBlockExpose(c *gc.C) {
 	ch := testcharms.Repo.CharmArchivePath(s.CharmsPath, "dummy")
 	err := runDeploy(c, ch, "some-application-name", "--series", "trusty")
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/juju/errors"
+	csparams "gopkg.in/juju/charmrepo.v2-unstable/csclient/params"
 
 	"github.com/juju/juju/storage"
 )
@@ -112,3 +113,38 @@ func (m stringMap) String() string {
 	}
 	return strings.Join(pairs, ";")
 }
+
+// channelFlag is a gnuflag.Value that accepts the name of a charm store
+// channel, rejecting unknown channels before any request is made.
+type channelFlag struct {
+	channel *csparams.Channel
+}
+
+// knownChannels holds the channels that may be specified with --channel.
+var knownChannels = []csparams.Channel{
+	csparams.StableChannel,
+	csparams.CandidateChannel,
+	csparams.BetaChannel,
+	csparams.EdgeChannel,
+	csparams.UnpublishedChannel,
+}
+
+// Set implements gnuflag.Value.Set.
+func (f channelFlag) Set(s string) error {
+	for _, channel := range knownChannels {
+		if s == string(channel) {
+			*f.channel = channel
+			return nil
+		}
+	}
+	names := make([]string, len(knownChannels))
+	for i, channel := range knownChannels {
+		names[i] = string(channel)
+	}
+	return errors.Errorf("unknown channel %q, expected one of %s", s, strings.Join(names, ", "))
+}
+
+// String implements gnuflag.Value.String.
+func (f channelFlag) String() string {
+	return string(*f.channel)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
+	csparams "gopkg.in/juju/charmrepo.v2-unstable/csclient/params"
 
 	"github.com/juju/juju/storage"
 )
@@ -93,3 +94,20 @@ func (FlagSuite) TestStorageFlagBundleStorageErrors(c *gc.C) {
 	err := flag.Set("foo")
 	c.Assert(err, gc.ErrorMatches, `expected \[<application>\:]<store>=<constraints>`)
 }
+
+func (FlagSuite) TestChannelFlag(c *gc.C) {
+	var channel csparams.Channel
+	f := channelFlag{&channel}
+	err := f.Set("edge")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(channel, gc.Equals, csparams.EdgeChannel)
+	c.Assert(f.String(), gc.Equals, "edge")
+}
+
+func (FlagSuite) TestChannelFlagUnknown(c *gc.C) {
+	var channel csparams.Channel
+	f := channelFlag{&channel}
+	err := f.Set("stabel")
+	c.Assert(err, gc.ErrorMatches, `unknown channel "stabel", expected one of stable, candidate, beta, edge, unpublished`)
+	c.Assert(channel, gc.Equals, csparams.NoChannel)
+}
@@ -63,6 +63,11 @@ func (s *UnexposeSuite) TestUnexpose(c *gc.C) {
 	})
 }
 
+func (s *UnexposeSuite) TestUnexposeInvalidApplicationName(c *gc.C) {
+	err := runUnexpose(c, "wordpress/0")
+	c.Assert(err, gc.ErrorMatches, `invalid application name "wordpress/0"`)
+}
+
 func (s *UnexposeSuite) TestBlockUnexpose(c *gc.C) {
 	ch := testcharms.Repo.CharmArchivePath(s.CharmsPath, "dummy")
 	err := runDeploy(c, ch, "some-application-name", "--series", "trusty")
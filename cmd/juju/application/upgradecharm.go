@@ -206,7 +206,7 @@ func (c *upgradeCharmCommand) Info() *cmd.Info {
 func (c *upgradeCharmCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	f.BoolVar(&c.ForceUnits, "force-units", false, "Upgrade all units immediately, even if in error state")
-	f.StringVar((*string)(&c.Channel), "channel", "", "Channel to use when getting the charm or bundle from the charm store")
+	f.Var(channelFlag{&c.Channel}, "channel", "Channel to use when getting the charm or bundle from the charm store")
 	f.BoolVar(&c.ForceSeries, "force-series", false, "Upgrade even if series of deployed applications are not supported by the new charm")
 	f.StringVar(&c.SwitchURL, "switch", "", "Crossgrade to a different charm")
 	f.StringVar(&c.CharmPath, "path", "", "Upgrade to a charm located at path")
@@ -520,6 +520,11 @@ func (c *upgradeCharmCommand) addCharm(
 	if err != os.ErrNotExist && !charmrepo.IsInvalidPathError(err) {
 		return id, nil, err
 	}
+	// A reference supplied with --path must be a path; don't fall back
+	// to interpreting it as a charm URL.
+	if c.CharmPath != "" {
+		return id, nil, errors.Errorf("no charm found at path %q", charmRef)
+	}
 
 	refURL, err := charm.ParseURL(charmRef)
 	if err != nil {
@@ -516,6 +516,13 @@ func (s *UpgradeCharmSuccessStateSuite) TestCharmPathNoRevUpgrade(c *gc.C) {
 	c.Assert(curl.String(), gc.Equals, "local:quantal/riak-8")
 }
 
+func (s *UpgradeCharmSuccessStateSuite) TestCharmPathNotFound(c *gc.C) {
+	missingPath := filepath.Join(c.MkDir(), "riak")
+	err := runUpgradeCharm(c, "riak", "--path", missingPath)
+	c.Assert(err, gc.ErrorMatches, `no charm found at path ".*/riak"`)
+	s.assertUpgraded(c, s.riak, 7, false)
+}
+
 func (s *UpgradeCharmSuccessStateSuite) TestCharmPathDifferentNameFails(c *gc.C) {
 	myriakPath := testcharms.Repo.RenamedClonedDirPath(s.CharmsPath, "riak", "myriak")
 	metadataPath := filepath.Join(myriakPath, "metadata.yaml")
@@ -19,8 +19,8 @@ import (
 const downloadDoc = `
 download-backup retrieves a backup archive file.
 
-If --filename is not used, the archive is downloaded to a temporary
-location and the filename is printed to stdout.
+If --filename is not used, the archive is downloaded to the current
+directory as juju-backup-<ID>.tar.gz. The filename is printed to stdout.
 `
 
 // NewDownloadCommand returns a commant used to download backups.
@@ -92,11 +92,15 @@ func (c *downloadCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return errors.Annotate(err, "while creating local archive file")
 	}
-	defer archive.Close()
 
-	// Write out the archive.
+	// Write out the archive, making sure a partial download
+	// is not left behind on failure.
 	_, err = io.Copy(archive, resultArchive)
+	if closeErr := archive.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
+		os.Remove(filename)
 		return errors.Annotate(err, "while creating local archive file")
 	}
 
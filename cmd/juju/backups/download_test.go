@@ -4,6 +4,11 @@
 package backups_test
 
 import (
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
@@ -71,3 +76,23 @@ func (s *downloadSuite) TestError(c *gc.C) {
 	_, err := testing.RunCommand(c, s.wrappedCommand, s.metaresult.ID)
 	c.Check(errors.Cause(err), gc.ErrorMatches, "failed!")
 }
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection lost")
+}
+
+func (s *downloadSuite) TestDownloadFailureRemovesPartialArchive(c *gc.C) {
+	client := s.setSuccess()
+	client.archive = ioutil.NopCloser(io.MultiReader(
+		strings.NewReader(s.data),
+		failingReader{},
+	))
+	_, err := testing.RunCommand(c, s.wrappedCommand, s.metaresult.ID)
+	c.Check(err, gc.ErrorMatches, "while creating local archive file: connection lost")
+
+	s.filename = "juju-backup-" + s.metaresult.ID + ".tar.gz"
+	_, err = os.Stat(s.filename)
+	c.Check(os.IsNotExist(err), jc.IsTrue)
+}
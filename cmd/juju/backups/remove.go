@@ -13,7 +13,16 @@ import (
 )
 
 const removeDoc = `
-remove-backup removes a backup from remote storage.
+remove-backup removes a backup from remote storage. The ID of the
+backup is shown by "juju backups". Removed backups cannot be recovered;
+use download-backup first to keep a local copy.
+
+Examples:
+    juju remove-backup 20161016-091532.9d8f6a2e-5b3c-4f1d-8a7e-4c2b1e0f3d5a
+
+See also:
+    backups
+    download-backup
 `
 
 // NewRemoveCommand returns a command used to remove a
@@ -33,7 +42,7 @@ func (c *removeCommand) Info() *cmd.Info {
 	return &cmd.Info{
 		Name:    "remove-backup",
 		Args:    "<ID>",
-		Purpose: "Remove the spcified backup from remote storage.",
+		Purpose: "Remove the specified backup from remote storage.",
 		Doc:     removeDoc,
 	}
 }
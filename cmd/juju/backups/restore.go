@@ -132,7 +132,7 @@ func (c *restoreCommand) Init(args []string) error {
 			return errors.Trace(err)
 		}
 	}
-	return nil
+	return cmd.CheckEmpty(args)
 }
 
 type restoreBootstrapParams struct {
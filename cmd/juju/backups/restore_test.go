@@ -96,14 +96,17 @@ func (s *restoreSuite) SetUpTest(c *gc.C) {
 
 func (s *restoreSuite) TestRestoreArgs(c *gc.C) {
 	s.command = backups.NewRestoreCommandForTest(s.store, nil, nil, nil, nil)
-	_, err := testing.RunCommand(c, s.command, "restore")
+	_, err := testing.RunCommand(c, s.command)
 	c.Assert(err, gc.ErrorMatches, "you must specify either a file or a backup id.")
 
-	_, err = testing.RunCommand(c, s.command, "restore", "--id", "anid", "--file", "afile")
+	_, err = testing.RunCommand(c, s.command, "--id", "anid", "--file", "afile")
 	c.Assert(err, gc.ErrorMatches, "you must specify either a file or a backup id but not both.")
 
-	_, err = testing.RunCommand(c, s.command, "restore", "--id", "anid", "-b")
+	_, err = testing.RunCommand(c, s.command, "--id", "anid", "-b")
 	c.Assert(err, gc.ErrorMatches, "it is not possible to rebootstrap and restore from an id.")
+
+	_, err = testing.RunCommand(c, s.command, "--id", "anid", "extra")
+	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["extra"\]`)
 }
 
 // TODO(wallyworld) - add more api related unit tests
@@ -137,7 +140,7 @@ func (s *restoreSuite) TestRestoreReboostrapControllerExists(c *gc.C) {
 		backups.GetEnvironFunc(fakeEnv),
 		backups.GetRebootstrapParamsFunc("mycloud"),
 	)
-	_, err := testing.RunCommand(c, s.command, "restore", "--file", "afile", "-b")
+	_, err := testing.RunCommand(c, s.command, "--file", "afile", "-b")
 	c.Assert(err, gc.ErrorMatches, ".*still seems to exist.*")
 }
 
@@ -157,7 +160,7 @@ func (s *restoreSuite) TestRestoreReboostrapNoControllers(c *gc.C) {
 		return errors.New("failed to bootstrap new controller")
 	})
 
-	_, err := testing.RunCommand(c, s.command, "restore", "--file", "afile", "-b")
+	_, err := testing.RunCommand(c, s.command, "--file", "afile", "-b")
 	c.Assert(err, gc.ErrorMatches, ".*failed to bootstrap new controller")
 }
 
@@ -178,7 +181,7 @@ func (s *restoreSuite) TestRestoreReboostrapReadsMetadata(c *gc.C) {
 		return errors.New("failed to bootstrap new controller")
 	})
 
-	_, err := testing.RunCommand(c, s.command, "restore", "-m", "testing:test1", "--file", "afile", "-b")
+	_, err := testing.RunCommand(c, s.command, "-m", "testing:test1", "--file", "afile", "-b")
 	c.Assert(err, gc.ErrorMatches, ".*failed to bootstrap new controller")
 }
 
@@ -201,7 +204,7 @@ func (s *restoreSuite) TestFailedRestoreReboostrapMaintainsControllerInfo(c *gc.
 		return nil
 	})
 
-	_, err := testing.RunCommand(c, s.command, "restore", "-m", "testing:test1", "--file", "afile", "-b")
+	_, err := testing.RunCommand(c, s.command, "-m", "testing:test1", "--file", "afile", "-b")
 	c.Assert(err, gc.ErrorMatches, "failed")
 	// The details below are as per what was done in test setup, so no changes.
 	c.Assert(s.store.Controllers["testing"], jc.DeepEquals, jujuclient.ControllerDetails{
@@ -245,7 +248,7 @@ func (s *restoreSuite) TestRestoreReboostrapWritesUpdatedControllerInfo(c *gc.C)
 		return &i
 	}
 
-	_, err := testing.RunCommand(c, s.command, "restore", "-m", "testing:test1", "--file", "afile", "-b")
+	_, err := testing.RunCommand(c, s.command, "-m", "testing:test1", "--file", "afile", "-b")
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(boostrapped, jc.IsTrue)
 	c.Assert(s.store.Controllers["testing"], jc.DeepEquals, jujuclient.ControllerDetails{
@@ -288,7 +291,7 @@ func (s *restoreSuite) TestRestoreReboostrapBuiltInProvider(c *gc.C) {
 		return nil
 	})
 
-	_, err := testing.RunCommand(c, s.command, "restore", "-m", "testing:test1", "--file", "afile", "-b")
+	_, err := testing.RunCommand(c, s.command, "-m", "testing:test1", "--file", "afile", "-b")
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(boostrapped, jc.IsTrue)
 }
@@ -70,11 +70,7 @@ func (c *listCommand) Info() *cmd.Info {
 func (c *listCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	f.BoolVar(&c.all, "all", false, "Lists for all models (administrative users only)")
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"yaml":    cmd.FormatYaml,
-		"json":    cmd.FormatJson,
-		"tabular": c.formatter,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(c.formatter))
 }
 
 // Run implements Command.Run.
@@ -53,11 +53,7 @@ func (c *listRegionsCommand) Info() *cmd.Info {
 // SetFlags implements Command.SetFlags.
 func (c *listRegionsCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.CommandBase.SetFlags(f)
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"yaml":    cmd.FormatYaml,
-		"json":    cmd.FormatJson,
-		"tabular": c.formatRegionsListTabular,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(c.formatRegionsListTabular))
 }
 
 // Init implements Command.Init.
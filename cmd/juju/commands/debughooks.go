@@ -10,10 +10,12 @@ import (
 
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
+	"gopkg.in/juju/charm.v6-unstable"
 	"gopkg.in/juju/charm.v6-unstable/hooks"
 	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/api/application"
+	"github.com/juju/juju/api/charms"
 	"github.com/juju/juju/cmd/modelcmd"
 	unitdebug "github.com/juju/juju/worker/uniter/runner/debug"
 )
@@ -31,6 +33,12 @@ type debugHooksCommand struct {
 const debugHooksDoc = `
 Interactively debug a hook remotely on an application unit.
 
+Hook names may be unit hooks (e.g. "config-changed"), relation hooks
+prefixed with the relation name (e.g. "db-relation-joined"), storage
+hooks prefixed with the storage name (e.g. "data-storage-attached"), or
+action names. If no hook names are given, or if "*" is given, all hooks
+are debugged.
+
 See the "juju help ssh" for information about SSH related options
 accepted by the debug-hooks command.
 `
@@ -66,6 +74,11 @@ func (c *debugHooksCommand) Init(args []string) error {
 
 type charmRelationsAPI interface {
 	CharmRelations(serviceName string) ([]string, error)
+	GetCharmURL(serviceName string) (*charm.URL, error)
+}
+
+type charmInfoAPI interface {
+	CharmInfo(charmURL string) (*charms.CharmInfo, error)
 }
 
 func (c *debugHooksCommand) getServiceAPI() (charmRelationsAPI, error) {
@@ -76,6 +89,14 @@ func (c *debugHooksCommand) getServiceAPI() (charmRelationsAPI, error) {
 	return application.NewClient(root), nil
 }
 
+func (c *debugHooksCommand) getCharmsAPI() (charmInfoAPI, error) {
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return charms.NewClient(root), nil
+}
+
 func (c *debugHooksCommand) validateHooks() error {
 	if len(c.hooks) == 0 {
 		return nil
@@ -103,6 +124,34 @@ func (c *debugHooksCommand) validateHooks() error {
 			validHooks[hook] = true
 		}
 	}
+
+	// Storage hooks and actions are run through debug-hooks
+	// sessions too, so allow them to be named.
+	curl, err := serviceAPI.GetCharmURL(service)
+	if err != nil {
+		return err
+	}
+	charmsAPI, err := c.getCharmsAPI()
+	if err != nil {
+		return err
+	}
+	info, err := charmsAPI.CharmInfo(curl.String())
+	if err != nil {
+		return err
+	}
+	if info.Meta != nil {
+		for name := range info.Meta.Storage {
+			for _, hook := range []hooks.Kind{hooks.StorageAttached, hooks.StorageDetaching} {
+				hook := fmt.Sprintf("%s-%s", name, hook)
+				validHooks[hook] = true
+			}
+		}
+	}
+	if info.Actions != nil {
+		for name := range info.Actions.ActionSpecs {
+			validHooks[name] = true
+		}
+	}
 	for _, hook := range c.hooks {
 		if !validHooks[hook] {
 			names := make([]string, 0, len(validHooks))
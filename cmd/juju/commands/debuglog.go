@@ -76,14 +76,14 @@ Show all juju.worker.uniter logging module messages that are also unit
 wordpress/0 messages, and then show any new log messages which match the
 filter:
 
-    juju debug-log --replay 
+    juju debug-log --replay \
         --include-module juju.worker.uniter \
         --include unit-wordpress-0
 
 Show all messages from the juju.worker.uniter module, except those sent from
 machine-3 or machine-4, and then stop:
 
-    juju debug-log --replay --no-tail
+    juju debug-log --replay --no-tail \
         --include-module juju.worker.uniter \
         --exclude machine-3 \
         --exclude machine-4 
@@ -141,7 +141,7 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeModule), "include-module", "Only show log messages for these logging modules")
 	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeModule), "exclude-module", "Do not show log messages for these logging modules")
 
-	f.StringVar(&c.level, "l", "", "Log level to show, one of [TRACE, DEBUG, INFO, WARNING, ERROR]")
+	f.StringVar(&c.level, "l", "", "Log level to show, one of [TRACE, DEBUG, INFO, WARNING, ERROR, CRITICAL]")
 	f.StringVar(&c.level, "level", "", "")
 
 	f.UintVar(&c.params.Backlog, "n", defaultLineCount, "Show this many of the most recent (possibly filtered) lines, and continue to append")
@@ -149,7 +149,8 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.UintVar(&c.params.Limit, "limit", 0, "Exit once this many of the most recent (possibly filtered) lines are shown")
 	f.BoolVar(&c.params.Replay, "replay", false, "Show the entire (possibly filtered) log and continue to append")
 
-	f.BoolVar(&c.notail, "no-tail", false, "Stop after returning existing log messages")
+	f.BoolVar(&c.notail, "T", false, "Stop after returning existing log messages")
+	f.BoolVar(&c.notail, "no-tail", false, "")
 	f.BoolVar(&c.tail, "tail", false, "Wait for new logs")
 	f.BoolVar(&c.color, "color", false, "Force use of ANSI color codes")
 
@@ -162,9 +163,9 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 func (c *debugLogCommand) Init(args []string) error {
 	if c.level != "" {
 		level, ok := loggo.ParseLevel(c.level)
-		if !ok || level < loggo.TRACE || level > loggo.ERROR {
-			return errors.Errorf("level value %q is not one of %q, %q, %q, %q, %q",
-				c.level, loggo.TRACE, loggo.DEBUG, loggo.INFO, loggo.WARNING, loggo.ERROR)
+		if !ok || level < loggo.TRACE || level > loggo.CRITICAL {
+			return errors.Errorf("level value %q is not one of %q, %q, %q, %q, %q, %q",
+				c.level, loggo.TRACE, loggo.DEBUG, loggo.INFO, loggo.WARNING, loggo.ERROR, loggo.CRITICAL)
 		}
 		c.params.Level = level
 	}
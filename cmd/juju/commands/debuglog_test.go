@@ -40,7 +40,13 @@ func (s *DebugLogSuite) TestArgParsing(c *gc.C) {
 			},
 		}, {
 			args:     []string{"-l", "foo"},
-			errMatch: `level value "foo" is not one of "TRACE", "DEBUG", "INFO", "WARNING", "ERROR"`,
+			errMatch: `level value "foo" is not one of "TRACE", "DEBUG", "INFO", "WARNING", "ERROR", "CRITICAL"`,
+		}, {
+			args: []string{"--level=CRITICAL"},
+			expected: api.DebugLogParams{
+				Backlog: 10,
+				Level:   loggo.CRITICAL,
+			},
 		}, {
 			args: []string{"--level=INFO"},
 			expected: api.DebugLogParams{
@@ -80,6 +86,9 @@ func (s *DebugLogSuite) TestArgParsing(c *gc.C) {
 		}, {
 			args:     []string{"--no-tail", "--tail"},
 			errMatch: `setting --tail and --no-tail not valid`,
+		}, {
+			args:     []string{"-T", "--tail"},
+			errMatch: `setting --tail and --no-tail not valid`,
 		}, {
 			args: []string{"--limit", "100"},
 			expected: api.DebugLogParams{
@@ -123,6 +132,19 @@ func (s *DebugLogSuite) TestParamsPassed(c *gc.C) {
 	})
 }
 
+func (s *DebugLogSuite) TestShortNoTail(c *gc.C) {
+	fake := &fakeDebugLogAPI{}
+	s.PatchValue(&getDebugLogAPI, func(_ *debugLogCommand) (DebugLogAPI, error) {
+		return fake, nil
+	})
+	_, err := testing.RunCommand(c, newDebugLogCommand(), "-T")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(fake.params, gc.DeepEquals, api.DebugLogParams{
+		Backlog: 10,
+		NoTail:  true,
+	})
+}
+
 func (s *DebugLogSuite) TestLogOutput(c *gc.C) {
 	// test timezone is 6 hours east of UTC
 	tz := time.FixedZone("test", 6*60*60)
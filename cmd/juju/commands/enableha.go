@@ -169,7 +169,11 @@ func (c *enableHACommand) Init(args []string) error {
 		placementSpecs := strings.Split(c.PlacementSpec, ",")
 		c.Placement = make([]string, len(placementSpecs))
 		for i, spec := range placementSpecs {
-			p, err := instance.ParsePlacement(strings.TrimSpace(spec))
+			spec = strings.TrimSpace(spec)
+			if spec == "" {
+				return errors.Errorf("empty enable-ha placement directive in %q", c.PlacementSpec)
+			}
+			p, err := instance.ParsePlacement(spec)
 			if err == nil && names.IsContainerMachine(p.Directive) {
 				return errors.New("enable-ha cannot be used with container placement directives")
 			}
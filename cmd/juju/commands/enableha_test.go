@@ -204,6 +204,14 @@ func (s *EnableHASuite) TestEnableHAErrors(c *gc.C) {
 	c.Assert(s.fake.numControllers, gc.Equals, invalidNumServers)
 }
 
+func (s *EnableHASuite) TestEnableHAEmptyPlacement(c *gc.C) {
+	_, err := s.runEnableHA(c, "--to", "1,")
+	c.Assert(err, gc.ErrorMatches, `empty enable-ha placement directive in "1,"`)
+
+	// Verify that enable-ha didn't call into the API
+	c.Assert(s.fake.numControllers, gc.Equals, invalidNumServers)
+}
+
 func (s *EnableHASuite) TestEnableHAAllows0(c *gc.C) {
 	// If the number of controllers is specified as "0", the API will
 	// then use the default number of 3.
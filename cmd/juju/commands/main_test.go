@@ -422,6 +422,7 @@ var commandNames = []string{
 	"deploy",
 	"destroy-controller",
 	"destroy-model",
+	"detach-storage",
 	"disable-command",
 	"disable-user",
 	"disabled-commands",
@@ -430,6 +431,7 @@ var commandNames = []string{
 	"enable-command",
 	"enable-destroy-controller",
 	"enable-user",
+	"export-bundle",
 	"expose",
 	"get-constraints",
 	"get-model-constraints",
@@ -438,6 +440,7 @@ var commandNames = []string{
 	"help",
 	"help-tool",
 	"import-ssh-key",
+	"import-volume",
 	"kill-controller",
 	"list-actions",
 	"list-agreements",
@@ -477,6 +480,7 @@ var commandNames = []string{
 	"remove-machine",
 	"remove-relation",
 	"remove-ssh-key",
+	"remove-storage",
 	"remove-unit",
 	"resolved",
 	"restore-backup",
@@ -103,6 +103,13 @@ func (c *migrateCommand) getMigrationSpec() (*controller.MigrationSpec, error) {
 	if err != nil {
 		return nil, err
 	}
+	sourceInfo, err := store.ControllerByName(c.ControllerName())
+	if err != nil {
+		return nil, err
+	}
+	if controllerInfo.ControllerUUID == sourceInfo.ControllerUUID {
+		return nil, errors.Errorf("target controller %q is the model's current controller", c.targetController)
+	}
 
 	accountInfo, err := store.AccountDetails(c.targetController)
 	if err != nil {
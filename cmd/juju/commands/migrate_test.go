@@ -191,6 +191,12 @@ func (s *MigrateSuite) TestControllerDoesntExist(c *gc.C) {
 	c.Check(s.api.specSeen, gc.IsNil) // API shouldn't have been called
 }
 
+func (s *MigrateSuite) TestTargetIsSourceController(c *gc.C) {
+	_, err := s.makeAndRun(c, "model", "source")
+	c.Check(err, gc.ErrorMatches, `target controller "source" is the model's current controller`)
+	c.Check(s.api.specSeen, gc.IsNil) // API shouldn't have been called
+}
+
 func (s *MigrateSuite) makeAndRun(c *gc.C, args ...string) (*cmd.Context, error) {
 	return s.run(c, s.makeCommand(), args...)
 }
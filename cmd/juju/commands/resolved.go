@@ -13,6 +13,22 @@ import (
 	"github.com/juju/juju/cmd/modelcmd"
 )
 
+const resolvedDoc = `
+When a hook fails, the unit is put into an error state and no further
+hooks are run on it until the error is resolved. By default, resolved
+marks the error as resolved and re-executes the failed hook. If the
+problem has been fixed by other means, use --no-retry to mark the error
+resolved without running the hook again.
+
+Examples:
+    juju resolved mysql/0
+    juju resolved --no-retry mysql/0
+
+See also:
+    debug-hooks
+    status
+`
+
 func newResolvedCommand() cmd.Command {
 	return modelcmd.Wrap(&resolvedCommand{})
 }
@@ -29,6 +45,7 @@ func (c *resolvedCommand) Info() *cmd.Info {
 		Name:    "resolved",
 		Args:    "<unit>",
 		Purpose: "Marks unit errors resolved and re-executes failed hooks",
+		Doc:     resolvedDoc,
 	}
 }
 
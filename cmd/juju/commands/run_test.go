@@ -399,6 +399,26 @@ func (s *RunSuite) TestSingleResponse(c *gc.C) {
 	}
 }
 
+func (s *RunSuite) TestNoWaitOnceActionsComplete(c *gc.C) {
+	mock := s.setupMockAPI()
+	mock.setMachinesAlive("0")
+	mock.setResponse("0", mockResponse{
+		stdout:     "stdout\n",
+		machineTag: "machine-0",
+	})
+	mock.actionResponses = map[string]params.ActionResult{
+		mock.receiverIdMap["0"]: mock.runResponses["0"],
+	}
+	s.PatchValue(&afterFunc, func(time.Duration) <-chan time.Time {
+		c.Errorf("unexpected wait for completed actions")
+		return time.After(0)
+	})
+
+	context, err := testing.RunCommand(c, newRunCommand(), "--all", "ignored")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(testing.Stdout(context), gc.Equals, "stdout\n")
+}
+
 func (s *RunSuite) setupMockAPI() *mockRunAPI {
 	mock := &mockRunAPI{}
 	s.PatchValue(&getRunAPIClient, func(_ *runCommand) (RunClient, error) {
@@ -205,7 +205,7 @@ func (c *SSHCommon) generateKnownHosts(targets []*resolvedTarget) (string, error
 	}
 	defer f.Close()
 	c.knownHostsPath = f.Name() // Record for later deletion
-	if knownHosts.write(f); err != nil {
+	if err := knownHosts.write(f); err != nil {
 		return "", errors.Trace(err)
 	}
 	return c.knownHostsPath, nil
@@ -360,8 +360,7 @@ func (b *knownHostsBuilder) write(w io.Writer) error {
 			return errors.Annotate(err, "writing known hosts file")
 		}
 	}
-	bufw.Flush()
-	return nil
+	return errors.Annotate(bufw.Flush(), "writing known hosts file")
 }
 
 func (b *knownHostsBuilder) size() int {
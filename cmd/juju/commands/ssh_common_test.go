@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/utils/ssh"
 	gc "gopkg.in/check.v1"
@@ -199,3 +200,16 @@ func (s *SSHCommonSuite) setKeys(c *gc.C, m *state.Machine) {
 	err := s.State.SetSSHHostKeys(m.MachineTag(), keys)
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func (s *SSHCommonSuite) TestKnownHostsBuilderWriteError(c *gc.C) {
+	b := newKnownHostsBuilder()
+	b.add("1.2.3.4", []string{"ssh-rsa AAAA"})
+	err := b.write(failingWriter{})
+	c.Assert(err, gc.ErrorMatches, "writing known hosts file: disk full")
+}
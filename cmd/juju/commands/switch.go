@@ -82,6 +82,19 @@ func (c *switchCommand) Run(ctx *cmd.Context) (resultErr error) {
 		return errors.Trace(err)
 	}
 	if c.Target == "" {
+		// If JUJU_MODEL is set, it overrides the current model,
+		// so report that instead.
+		if model := os.Getenv(osenv.JujuModelEnvKey); model != "" {
+			controllerName, modelName := modelcmd.SplitModelName(model)
+			if controllerName == "" {
+				controllerName = currentControllerName
+			}
+			if controllerName != "" {
+				model = modelcmd.JoinModelName(controllerName, modelName)
+			}
+			fmt.Fprintf(ctx.Stdout, "%s\n", model)
+			return nil
+		}
 		currentName, err := c.name(store, currentControllerName, true)
 		if err != nil {
 			return errors.Trace(err)
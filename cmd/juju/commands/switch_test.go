@@ -271,6 +271,20 @@ func (s *SwitchSimpleSuite) TestSettingWhenEnvVarSet(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `cannot switch when JUJU_MODEL is overriding the model \(set to "using-model"\)`)
 }
 
+func (s *SwitchSimpleSuite) TestNoArgsWhenEnvVarSet(c *gc.C) {
+	s.addController(c, "a-controller")
+	s.store.CurrentControllerName = "a-controller"
+	os.Setenv("JUJU_MODEL", "using-model")
+	ctx, err := s.run(c)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(coretesting.Stdout(ctx), gc.Equals, "a-controller:using-model\n")
+
+	os.Setenv("JUJU_MODEL", "other-controller:other-model")
+	ctx, err = s.run(c)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(coretesting.Stdout(ctx), gc.Equals, "other-controller:other-model\n")
+}
+
 func (s *SwitchSimpleSuite) TestTooManyParams(c *gc.C) {
 	_, err := s.run(c, "foo", "bar")
 	c.Assert(err, gc.ErrorMatches, `unrecognized args: ."bar".`)
@@ -8,6 +8,7 @@ import (
 	"io"
 
 	"github.com/juju/cmd"
+	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
 	"github.com/juju/loggo"
 	"github.com/juju/version"
@@ -104,6 +105,9 @@ func (c *syncToolsCommand) Init(args []string) error {
 		}
 	}
 	if c.dev {
+		if c.stream != "" && c.stream != envtools.TestingStream {
+			return errors.Errorf("--dev and --stream %q cannot be used together", c.stream)
+		}
 		c.stream = envtools.TestingStream
 	}
 	return cmd.CheckEmpty(args)
@@ -279,6 +279,11 @@ func (s *syncToolsSuite) TestAPIAdapterUploadTools(c *gc.C) {
 	c.Assert(err, gc.Equals, uploadToolsErr)
 }
 
+func (s *syncToolsSuite) TestDevAndStreamConflict(c *gc.C) {
+	_, err := s.runSyncToolsCommand(c, "-m", "test-target", "--dev", "--stream", "released")
+	c.Assert(err, gc.ErrorMatches, `--dev and --stream "released" cannot be used together`)
+}
+
 func (s *syncToolsSuite) TestAPIAdapterBlockUploadTools(c *gc.C) {
 	syncTools = func(sctx *sync.SyncContext) error {
 		// Block operation
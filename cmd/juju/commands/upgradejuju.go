@@ -120,6 +120,11 @@ func (c *upgradeJujuCommand) Init(args []string) error {
 		}
 		c.Version = vers
 	}
+	if c.DryRun && c.ResetPrevious {
+		// A dry run must not change anything, including the status
+		// of any previous upgrade.
+		return errors.New("--dry-run cannot be used with --reset-previous-upgrade")
+	}
 	return cmd.CheckEmpty(args)
 }
 
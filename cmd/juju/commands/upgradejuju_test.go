@@ -115,6 +115,11 @@ var upgradeJujuTests = []struct {
 	currentVersion: "3.2.7-quantal-amd64",
 	args:           []string{"--build-agent", "--agent-version", "3.2.8.4"},
 	expectInitErr:  "cannot specify build number when building an agent",
+}, {
+	about:          "--dry-run with --reset-previous-upgrade",
+	currentVersion: "3.2.7-quantal-amd64",
+	args:           []string{"--dry-run", "--reset-previous-upgrade"},
+	expectInitErr:  "--dry-run cannot be used with --reset-previous-upgrade",
 }, {
 	about:          "latest supported stable release",
 	tools:          []string{"2.1.0-quantal-amd64", "2.1.2-quantal-i386", "2.1.3-quantal-amd64", "2.1-dev1-quantal-amd64"},
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/controller"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/cmd/output"
 	"github.com/juju/juju/environs/bootstrap"
 	"github.com/juju/juju/jujuclient"
 	"github.com/juju/juju/status"
@@ -58,11 +59,7 @@ func (c *listControllersCommand) Info() *cmd.Info {
 func (c *listControllersCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.JujuCommandBase.SetFlags(f)
 	f.BoolVar(&c.refresh, "refresh", false, "Connect to each controller to download the latest details")
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"yaml":    cmd.FormatYaml,
-		"json":    cmd.FormatJson,
-		"tabular": c.formatControllersListTabular,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(c.formatControllersListTabular))
 }
 
 func (c *listControllersCommand) getAPI(controllerName string) (ControllerAccessAPI, error) {
@@ -103,11 +103,7 @@ func (c *modelsCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.all, "all", false, "Lists all models, regardless of user accessibility (administrative users only)")
 	f.BoolVar(&c.listUUID, "uuid", false, "Display UUID for models")
 	f.BoolVar(&c.exactTime, "exact-time", false, "Use full timestamps")
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"yaml":    cmd.FormatYaml,
-		"json":    cmd.FormatJson,
-		"tabular": c.formatTabular,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(c.formatTabular))
 }
 
 // ModelSet contains the set of models known to the client,
@@ -131,6 +131,9 @@ func (c *addCommand) Init(args []string) error {
 	if c.Constraints.Container != nil {
 		return errors.Errorf("container constraint %q not allowed when adding a machine", *c.Constraints.Container)
 	}
+	if c.NumMachines < 1 {
+		return errors.New("-n must be a positive integer")
+	}
 	placement, err := cmd.ZeroOrOneArgs(args)
 	if err != nil {
 		return err
@@ -85,6 +85,12 @@ func (s *AddMachineSuite) TestInit(c *gc.C) {
 			args:      []string{"something:special"},
 			count:     1,
 			placement: "something:special",
+		}, {
+			args:        []string{"-n", "0"},
+			errorString: `-n must be a positive integer`,
+		}, {
+			args:        []string{"-n", "-1"},
+			errorString: `-n must be a positive integer`,
 		},
 	} {
 		c.Logf("test %d", i)
@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/api/metricsdebug"
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/cmd/output"
 )
 
 const metricsDoc = `
@@ -70,11 +71,7 @@ func (c *MetricsCommand) Init(args []string) error {
 // SetFlags implements cmd.Command.SetFlags.
 func (c *MetricsCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"tabular": formatTabular,
-		"json":    cmd.FormatJson,
-		"yaml":    cmd.FormatYaml,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(formatTabular))
 	f.BoolVar(&c.All, "all", false, "retrieve metrics collected by all units in the model")
 }
 
@@ -87,11 +87,7 @@ func (c *configCommand) Info() *cmd.Info {
 func (c *configCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"json":    cmd.FormatJson,
-		"tabular": formatConfigTabular,
-		"yaml":    cmd.FormatYaml,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(formatConfigTabular))
 	f.Var(cmd.NewAppendStringsValue(&c.reset), "reset", "Reset the provided comma delimited keys")
 }
 
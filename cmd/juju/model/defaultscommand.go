@@ -126,11 +126,7 @@ func (c *defaultsCommand) Info() *cmd.Info {
 func (c *defaultsCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ControllerCommandBase.SetFlags(f)
 
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"yaml":    cmd.FormatYaml,
-		"json":    cmd.FormatJson,
-		"tabular": formatDefaultConfigTabular,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(formatDefaultConfigTabular))
 	f.Var(cmd.NewAppendStringsValue(&c.reset), "reset", "Reset the provided comma delimited keys")
 }
 
@@ -233,7 +229,8 @@ func (c *defaultsCommand) Init(args []string) error {
 
 // parseResetKeys splits the keys provided to --reset after trimming any
 // leading or trailing comma. It then verifies that we haven't incorrectly
-// received any key=value pairs and finally sets the value(s) on c.resetKeys.
+// received any empty keys or key=value pairs and finally sets the value(s)
+// on c.resetKeys.
 func (c *defaultsCommand) parseResetKeys() error {
 	if len(c.reset) == 0 {
 		return nil
@@ -248,6 +245,9 @@ func (c *defaultsCommand) parseResetKeys() error {
 		if k == config.AgentVersionKey {
 			return errors.Errorf("%q cannot be reset", config.AgentVersionKey)
 		}
+		if k == "" {
+			return errors.New("--reset does not accept empty keys")
+		}
 		if strings.Contains(k, "=") {
 			return errors.Errorf(
 				`--reset accepts a comma delimited set of keys "a,b,c", received: %q`, k)
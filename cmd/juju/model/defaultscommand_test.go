@@ -103,6 +103,14 @@ func (s *DefaultsCommandSuite) TestDefaultsInit(c *gc.C) {
 			description: "test reset multiple with key=val fails",
 			args:        []string{"--reset", "a,foo=bar,b"},
 			errorMatch:  `--reset accepts a comma delimited set of keys "a,b,c", received: "foo=bar"`,
+		}, {
+			description: "test reset with empty key fails",
+			args:        []string{"--reset", "a,,b"},
+			errorMatch:  "--reset does not accept empty keys",
+		}, {
+			description: "test reset with only commas fails",
+			args:        []string{"--reset", ","},
+			errorMatch:  "--reset does not accept empty keys",
 		}, {
 			description: "test reset with two positional args fails expecting a region",
 			args:        []string{"--reset", "a", "b", "c"},
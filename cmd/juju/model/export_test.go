@@ -107,3 +107,11 @@ func NewRevokeCommandForTest(api RevokeModelAPI, store jujuclient.ClientStore) (
 	cmd.SetClientStore(store)
 	return modelcmd.WrapController(cmd), &RevokeCommand{cmd}
 }
+
+// NewExportBundleCommandForTest returns an ExportBundleCommand with the api provided as specified.
+func NewExportBundleCommandForTest(api ExportBundleAPI) cmd.Command {
+	cmd := &exportBundleCommand{
+		api: api,
+	}
+	return modelcmd.Wrap(cmd)
+}
@@ -0,0 +1,72 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/model"
+	"github.com/juju/juju/testing"
+)
+
+type exportBundleSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	fake *fakeExportBundleClient
+}
+
+var _ = gc.Suite(&exportBundleSuite{})
+
+type fakeExportBundleClient struct {
+	result string
+	err    error
+}
+
+func (f *fakeExportBundleClient) Close() error {
+	return nil
+}
+
+func (f *fakeExportBundleClient) ExportBundle() (string, error) {
+	return f.result, f.err
+}
+
+func (s *exportBundleSuite) SetUpTest(c *gc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.fake = &fakeExportBundleClient{
+		result: "applications:\n  mysql:\n    charm: cs:mysql-42\n",
+	}
+}
+
+func (s *exportBundleSuite) TestInitErrors(c *gc.C) {
+	_, err := testing.RunCommand(c, model.NewExportBundleCommandForTest(s.fake), "foo")
+	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["foo"\]`)
+}
+
+func (s *exportBundleSuite) TestExportBundle(c *gc.C) {
+	ctx, err := testing.RunCommand(c, model.NewExportBundleCommandForTest(s.fake))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(testing.Stdout(ctx), gc.Equals, s.fake.result)
+}
+
+func (s *exportBundleSuite) TestExportBundleFilename(c *gc.C) {
+	filename := filepath.Join(c.MkDir(), "bundle.yaml")
+	ctx, err := testing.RunCommand(c, model.NewExportBundleCommandForTest(s.fake), "--filename", filename)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(testing.Stdout(ctx), gc.Equals, "")
+	c.Assert(testing.Stderr(ctx), gc.Equals, "Bundle successfully exported to "+filename+"\n")
+
+	data, err := ioutil.ReadFile(filename)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Equals, s.fake.result)
+}
+
+func (s *exportBundleSuite) TestExportBundleError(c *gc.C) {
+	s.fake.err = errors.New("boom")
+	_, err := testing.RunCommand(c, model.NewExportBundleCommandForTest(s.fake))
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
@@ -15,6 +15,26 @@ import (
 	"github.com/juju/juju/cmd/modelcmd"
 )
 
+const retryProvisioningDoc = `
+When a machine fails to provision, for example because of a transient
+cloud error, it is left in an error state. retry-provisioning marks the
+machine's error as transient so that the provisioner attempts to start
+the instance again, without the machine having to be removed and added.
+
+Only machines in an error state can be retried; containers are not
+supported.
+
+Examples:
+    juju retry-provisioning 0
+    juju retry-provisioning 3 4
+
+See also:
+    add-machine
+    remove-machine
+`
+
+// NewRetryProvisioningCommand returns a command used to retry
+// provisioning of machines that failed to start.
 func NewRetryProvisioningCommand() cmd.Command {
 	return modelcmd.Wrap(&retryProvisioningCommand{})
 }
@@ -39,6 +59,7 @@ func (c *retryProvisioningCommand) Info() *cmd.Info {
 		Name:    "retry-provisioning",
 		Args:    "<machine> [...]",
 		Purpose: "Retries provisioning for failed machines.",
+		Doc:     retryProvisioningDoc,
 	}
 }
 
@@ -52,11 +52,7 @@ func (c *listCommand) Info() *cmd.Info {
 // SetFlags is defined on the cmd.Command interface.
 func (c *listCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.SpaceCommandBase.SetFlags(f)
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"yaml":    cmd.FormatYaml,
-		"json":    cmd.FormatJson,
-		"tabular": c.printTabular,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(c.printTabular))
 	f.BoolVar(&c.Short, "short", false, "only display spaces.")
 }
 
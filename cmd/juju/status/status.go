@@ -76,6 +76,7 @@ The available output formats are:
 Examples:
     juju show-status
     juju show-status mysql
+    juju show-status mysql/*
     juju show-status nova-*
 
 See also:
@@ -114,6 +115,13 @@ func (c *statusCommand) SetFlags(f *gnuflag.FlagSet) {
 }
 
 func (c *statusCommand) Init(args []string) error {
+	for _, pattern := range args {
+		// An empty pattern would otherwise be treated as a prefix
+		// of every port range, matching far more than intended.
+		if pattern == "" {
+			return errors.New("empty filter pattern not valid")
+		}
+	}
 	c.patterns = args
 	// If use of ISO time not specified on command line,
 	// check env var.
@@ -4294,6 +4294,12 @@ var statusTimeTest = test(
 	},
 )
 
+func (s *StatusSuite) TestEmptyFilterPattern(c *gc.C) {
+	code, _, stderr := runStatus(c, "mysql", "")
+	c.Check(code, gc.Equals, 2)
+	c.Check(string(stderr), gc.Equals, "error: empty filter pattern not valid\n")
+}
+
 func (s *StatusSuite) TestIsoTimeFormat(c *gc.C) {
 	func(t testCase) {
 		// Prepare context and run all steps to setup.
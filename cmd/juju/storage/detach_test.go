@@ -0,0 +1,91 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/testing"
+)
+
+type detachStorageSuite struct {
+	SubStorageSuite
+	mockAPI *mockDetachStorageAPI
+}
+
+var _ = gc.Suite(&detachStorageSuite{})
+
+func (s *detachStorageSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.mockAPI = &mockDetachStorageAPI{
+		version: 4,
+		detachFunc: func(tags []names.StorageTag) ([]params.ErrorResult, error) {
+			return make([]params.ErrorResult, len(tags)), nil
+		},
+	}
+}
+
+func (s *detachStorageSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	return testing.RunCommand(c, storage.NewDetachStorageCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *detachStorageSuite) TestDetachNoArgs(c *gc.C) {
+	_, err := s.run(c)
+	c.Assert(err, gc.ErrorMatches, "detach-storage requires at least one storage ID")
+}
+
+func (s *detachStorageSuite) TestDetach(c *gc.C) {
+	var detached []names.StorageTag
+	s.mockAPI.detachFunc = func(tags []names.StorageTag) ([]params.ErrorResult, error) {
+		detached = tags
+		return make([]params.ErrorResult, len(tags)), nil
+	}
+	ctx, err := s.run(c, "pgdata/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(detached, jc.DeepEquals, []names.StorageTag{names.NewStorageTag("pgdata/0")})
+	c.Assert(testing.Stderr(ctx), gc.Equals, "detaching pgdata/0\n")
+}
+
+func (s *detachStorageSuite) TestDetachFailure(c *gc.C) {
+	s.mockAPI.detachFunc = func(tags []names.StorageTag) ([]params.ErrorResult, error) {
+		return []params.ErrorResult{
+			{Error: &params.Error{Message: "storage pgdata/0 not found"}},
+		}, nil
+	}
+	ctx, err := s.run(c, "pgdata/0")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(testing.Stderr(ctx), gc.Equals, "failed to detach pgdata/0: storage pgdata/0 not found\n")
+}
+
+func (s *detachStorageSuite) TestDetachNotSupported(c *gc.C) {
+	s.mockAPI.version = 3
+	s.mockAPI.detachFunc = func(tags []names.StorageTag) ([]params.ErrorResult, error) {
+		c.Fatalf("unexpected call")
+		return nil, nil
+	}
+	_, err := s.run(c, "pgdata/0")
+	c.Assert(err, gc.ErrorMatches, "detach-storage is not supported by this controller")
+}
+
+type mockDetachStorageAPI struct {
+	version    int
+	detachFunc func([]names.StorageTag) ([]params.ErrorResult, error)
+}
+
+func (*mockDetachStorageAPI) Close() error {
+	return nil
+}
+
+func (m *mockDetachStorageAPI) BestAPIVersion() int {
+	return m.version
+}
+
+func (m *mockDetachStorageAPI) Detach(tags []names.StorageTag) ([]params.ErrorResult, error) {
+	return m.detachFunc(tags)
+}
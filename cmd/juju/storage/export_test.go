@@ -54,3 +54,27 @@ func NewAddCommandForTest(api StorageAddAPI, store jujuclient.ClientStore) cmd.C
 	cmd.SetClientStore(store)
 	return modelcmd.Wrap(cmd)
 }
+
+func NewRemoveStorageCommandForTest(api StorageRemoveAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &removeStorageCommand{newAPIFunc: func() (StorageRemoveAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewDetachStorageCommandForTest(api StorageDetachAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &detachStorageCommand{newAPIFunc: func() (StorageDetachAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewImportVolumeCommandForTest(api VolumeImportAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &importVolumeCommand{newAPIFunc: func() (VolumeImportAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
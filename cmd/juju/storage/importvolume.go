@@ -0,0 +1,104 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewImportVolumeCommand returns a command used to import existing
+// cloud volumes into the model.
+func NewImportVolumeCommand() cmd.Command {
+	cmd := &importVolumeCommand{}
+	cmd.newAPIFunc = func() (VolumeImportAPI, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	importVolumeCommandDoc = `
+Brings an existing volume, created outside of Juju, under the
+management of the model. The volume is identified by its ID in the
+cloud, and must belong to the storage provider of the given pool.
+
+The volume is tagged as belonging to the model, and is added to the
+model detached. It will not be destroyed by Juju unless it is removed
+explicitly.
+
+Only storage providers that manage volumes independently of machines,
+such as "ebs", support importing volumes.
+
+Examples:
+    juju import-volume ebs vol-123456
+    juju import-volume ebs-ssd vol-abcdef
+`
+	importVolumeCommandArgs = `<pool> <provider volume ID>`
+)
+
+// VolumeImportAPI defines the API methods that the import-volume
+// command uses.
+type VolumeImportAPI interface {
+	Close() error
+	BestAPIVersion() int
+	ImportVolume(pool, providerId string) (names.VolumeTag, error)
+}
+
+// importVolumeCommand imports an existing cloud volume.
+type importVolumeCommand struct {
+	StorageCommandBase
+	pool       string
+	providerId string
+	newAPIFunc func() (VolumeImportAPI, error)
+}
+
+// Info implements Command.Info.
+func (c *importVolumeCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "import-volume",
+		Purpose: "Imports an existing cloud volume into the model.",
+		Doc:     importVolumeCommandDoc,
+		Args:    importVolumeCommandArgs,
+	}
+}
+
+// Init implements Command.Init.
+func (c *importVolumeCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.New("import-volume requires a pool and a provider volume ID")
+	case 1:
+		return errors.New("import-volume requires a provider volume ID")
+	}
+	c.pool, c.providerId = args[0], args[1]
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Run implements Command.Run.
+func (c *importVolumeCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+	if api.BestAPIVersion() < 5 {
+		return errors.New("import-volume is not supported by this controller")
+	}
+
+	volumeTag, err := api.ImportVolume(c.pool, c.providerId)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "import volumes")
+		}
+		return err
+	}
+	ctx.Infof("imported %s as volume %s", c.providerId, volumeTag.Id())
+	return nil
+}
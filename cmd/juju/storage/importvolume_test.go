@@ -0,0 +1,99 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/testing"
+)
+
+type importVolumeSuite struct {
+	SubStorageSuite
+	mockAPI *mockVolumeImportAPI
+}
+
+var _ = gc.Suite(&importVolumeSuite{})
+
+func (s *importVolumeSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.mockAPI = &mockVolumeImportAPI{
+		version: 5,
+		importVolumeFunc: func(pool, providerId string) (names.VolumeTag, error) {
+			return names.NewVolumeTag("0"), nil
+		},
+	}
+}
+
+func (s *importVolumeSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	return testing.RunCommand(c, storage.NewImportVolumeCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *importVolumeSuite) TestImportNoArgs(c *gc.C) {
+	_, err := s.run(c)
+	c.Assert(err, gc.ErrorMatches, "import-volume requires a pool and a provider volume ID")
+}
+
+func (s *importVolumeSuite) TestImportNoProviderId(c *gc.C) {
+	_, err := s.run(c, "ebs")
+	c.Assert(err, gc.ErrorMatches, "import-volume requires a provider volume ID")
+}
+
+func (s *importVolumeSuite) TestImportTooManyArgs(c *gc.C) {
+	_, err := s.run(c, "ebs", "vol-123", "vol-456")
+	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["vol-456"\]`)
+}
+
+func (s *importVolumeSuite) TestImport(c *gc.C) {
+	var pool, providerId string
+	s.mockAPI.importVolumeFunc = func(p, id string) (names.VolumeTag, error) {
+		pool, providerId = p, id
+		return names.NewVolumeTag("0"), nil
+	}
+	ctx, err := s.run(c, "ebs", "vol-123")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(pool, gc.Equals, "ebs")
+	c.Assert(providerId, gc.Equals, "vol-123")
+	c.Assert(testing.Stderr(ctx), gc.Equals, "imported vol-123 as volume 0\n")
+}
+
+func (s *importVolumeSuite) TestImportAPIError(c *gc.C) {
+	s.mockAPI.importVolumeFunc = func(string, string) (names.VolumeTag, error) {
+		return names.VolumeTag{}, errors.New("kaboom")
+	}
+	_, err := s.run(c, "ebs", "vol-123")
+	c.Assert(err, gc.ErrorMatches, "kaboom")
+}
+
+func (s *importVolumeSuite) TestImportNotSupported(c *gc.C) {
+	s.mockAPI.version = 4
+	s.mockAPI.importVolumeFunc = func(string, string) (names.VolumeTag, error) {
+		c.Fatalf("unexpected call")
+		return names.VolumeTag{}, nil
+	}
+	_, err := s.run(c, "ebs", "vol-123")
+	c.Assert(err, gc.ErrorMatches, "import-volume is not supported by this controller")
+}
+
+type mockVolumeImportAPI struct {
+	version          int
+	importVolumeFunc func(pool, providerId string) (names.VolumeTag, error)
+}
+
+func (*mockVolumeImportAPI) Close() error {
+	return nil
+}
+
+func (m *mockVolumeImportAPI) BestAPIVersion() int {
+	return m.version
+}
+
+func (m *mockVolumeImportAPI) ImportVolume(pool, providerId string) (names.VolumeTag, error) {
+	return m.importVolumeFunc(pool, providerId)
+}
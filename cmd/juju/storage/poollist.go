@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/cmd/output"
 )
 
 // PoolCommandBase is a helper base structure for pool commands.
@@ -85,11 +86,7 @@ func (c *poolListCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(cmd.NewAppendStringsValue(&c.Providers), "provider", "Only show pools of these provider types")
 	f.Var(cmd.NewAppendStringsValue(&c.Names), "name", "Only show pools with these names")
 
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"yaml":    cmd.FormatYaml,
-		"json":    cmd.FormatJson,
-		"tabular": formatPoolListTabular,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(formatPoolListTabular))
 }
 
 // Run implements Command.Run.
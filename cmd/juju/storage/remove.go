@@ -0,0 +1,134 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewRemoveStorageCommand returns a command used to remove storage
+// instances from the model.
+func NewRemoveStorageCommand() cmd.Command {
+	cmd := &removeStorageCommand{}
+	cmd.newAPIFunc = func() (StorageRemoveAPI, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	removeStorageCommandDoc = `
+Removes storage instances from the model. Any storage attachments are
+first detached from their units; once detached, the storage provisioner
+destroys the underlying volumes and filesystems.
+
+Examples:
+    juju remove-storage pgdata/0
+    juju remove-storage pgdata/0 pgdata/1
+`
+	removeStorageCommandArgs = `<storage ID> [<storage ID> ...]`
+)
+
+// StorageRemoveAPI defines the API methods that the remove-storage
+// command uses.
+type StorageRemoveAPI interface {
+	Close() error
+	BestAPIVersion() int
+	Destroy([]names.StorageTag) ([]params.ErrorResult, error)
+}
+
+// removeStorageCommand removes storage instances.
+type removeStorageCommand struct {
+	StorageCommandBase
+	storageTags []names.StorageTag
+	newAPIFunc  func() (StorageRemoveAPI, error)
+}
+
+// Info implements Command.Info.
+func (c *removeStorageCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "remove-storage",
+		Purpose: "Removes storage from the model.",
+		Doc:     removeStorageCommandDoc,
+		Args:    removeStorageCommandArgs,
+	}
+}
+
+// Init implements Command.Init.
+func (c *removeStorageCommand) Init(args []string) (err error) {
+	if len(args) < 1 {
+		return errors.New("remove-storage requires at least one storage ID")
+	}
+	c.storageTags, err = parseStorageTags(args)
+	return err
+}
+
+// Run implements Command.Run.
+func (c *removeStorageCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+	if api.BestAPIVersion() < 4 {
+		return errors.New("remove-storage is not supported by this controller")
+	}
+
+	results, err := api.Destroy(c.storageTags)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "remove storage")
+		}
+		return err
+	}
+	return reportStorageResults(ctx, "remove", "removing", c.storageTags, results)
+}
+
+// parseStorageTags converts the given storage IDs into storage tags,
+// returning an error if any of them is invalid.
+func parseStorageTags(ids []string) ([]names.StorageTag, error) {
+	tags := make([]names.StorageTag, len(ids))
+	for i, id := range ids {
+		if !names.IsValidStorage(id) {
+			return nil, errors.NotValidf("storage ID %q", id)
+		}
+		tags[i] = names.NewStorageTag(id)
+	}
+	return tags, nil
+}
+
+// reportStorageResults writes a line to stderr for each storage instance
+// operated on, reporting progress or failure. If any operation failed,
+// cmd.ErrSilent is returned.
+func reportStorageResults(
+	ctx *cmd.Context,
+	verb, progressive string,
+	tags []names.StorageTag,
+	results []params.ErrorResult,
+) error {
+	if len(results) != len(tags) {
+		return errors.Errorf("expected %d results, got %d", len(tags), len(results))
+	}
+	var failed bool
+	for i, result := range results {
+		if result.Error != nil {
+			fmt.Fprintf(ctx.Stderr, "failed to %s %s: %v\n", verb, tags[i].Id(), result.Error)
+			failed = true
+			continue
+		}
+		ctx.Infof("%s %s", progressive, tags[i].Id())
+	}
+	if failed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/testing"
+)
+
+type removeStorageSuite struct {
+	SubStorageSuite
+	mockAPI *mockRemoveStorageAPI
+}
+
+var _ = gc.Suite(&removeStorageSuite{})
+
+func (s *removeStorageSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.mockAPI = &mockRemoveStorageAPI{
+		version: 4,
+		destroyFunc: func(tags []names.StorageTag) ([]params.ErrorResult, error) {
+			return make([]params.ErrorResult, len(tags)), nil
+		},
+	}
+}
+
+func (s *removeStorageSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	return testing.RunCommand(c, storage.NewRemoveStorageCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *removeStorageSuite) TestRemoveNoArgs(c *gc.C) {
+	_, err := s.run(c)
+	c.Assert(err, gc.ErrorMatches, "remove-storage requires at least one storage ID")
+}
+
+func (s *removeStorageSuite) TestRemoveInvalidStorageId(c *gc.C) {
+	_, err := s.run(c, "pgdata/0", "pgdata")
+	c.Assert(err, gc.ErrorMatches, `storage ID "pgdata" not valid`)
+}
+
+func (s *removeStorageSuite) TestRemove(c *gc.C) {
+	var destroyed []names.StorageTag
+	s.mockAPI.destroyFunc = func(tags []names.StorageTag) ([]params.ErrorResult, error) {
+		destroyed = tags
+		return make([]params.ErrorResult, len(tags)), nil
+	}
+	ctx, err := s.run(c, "pgdata/0", "pgdata/1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(destroyed, jc.DeepEquals, []names.StorageTag{
+		names.NewStorageTag("pgdata/0"),
+		names.NewStorageTag("pgdata/1"),
+	})
+	c.Assert(testing.Stderr(ctx), gc.Equals, `
+removing pgdata/0
+removing pgdata/1
+`[1:])
+}
+
+func (s *removeStorageSuite) TestRemoveFailure(c *gc.C) {
+	s.mockAPI.destroyFunc = func(tags []names.StorageTag) ([]params.ErrorResult, error) {
+		return []params.ErrorResult{
+			{Error: &params.Error{Message: "storage pgdata/0 not found"}},
+			{},
+		}, nil
+	}
+	ctx, err := s.run(c, "pgdata/0", "pgdata/1")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(testing.Stderr(ctx), gc.Equals, `
+failed to remove pgdata/0: storage pgdata/0 not found
+removing pgdata/1
+`[1:])
+}
+
+func (s *removeStorageSuite) TestRemoveAPIError(c *gc.C) {
+	s.mockAPI.destroyFunc = func(tags []names.StorageTag) ([]params.ErrorResult, error) {
+		return nil, errors.New("kaboom")
+	}
+	_, err := s.run(c, "pgdata/0")
+	c.Assert(err, gc.ErrorMatches, "kaboom")
+}
+
+func (s *removeStorageSuite) TestRemoveNotSupported(c *gc.C) {
+	s.mockAPI.version = 3
+	s.mockAPI.destroyFunc = func(tags []names.StorageTag) ([]params.ErrorResult, error) {
+		c.Fatalf("unexpected call")
+		return nil, nil
+	}
+	_, err := s.run(c, "pgdata/0")
+	c.Assert(err, gc.ErrorMatches, "remove-storage is not supported by this controller")
+}
+
+type mockRemoveStorageAPI struct {
+	version     int
+	destroyFunc func([]names.StorageTag) ([]params.ErrorResult, error)
+}
+
+func (*mockRemoveStorageAPI) Close() error {
+	return nil
+}
+
+func (m *mockRemoveStorageAPI) BestAPIVersion() int {
+	return m.version
+}
+
+func (m *mockRemoveStorageAPI) Destroy(tags []names.StorageTag) ([]params.ErrorResult, error) {
+	return m.destroyFunc(tags)
+}
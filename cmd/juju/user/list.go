@@ -95,11 +95,7 @@ func (c *listCommand) Info() *cmd.Info {
 func (c *listCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.infoCommandBase.SetFlags(f)
 	f.BoolVar(&c.All, "all", false, "Include disabled users")
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"yaml":    cmd.FormatYaml,
-		"json":    cmd.FormatJson,
-		"tabular": c.formatTabular,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(c.formatTabular))
 }
 
 // Init implements Command.Init.
@@ -52,11 +52,7 @@ func (c *whoAmICommand) Info() *cmd.Info {
 // SetFlags implements Command.SetFlags.
 func (c *whoAmICommand) SetFlags(f *gnuflag.FlagSet) {
 	c.JujuCommandBase.SetFlags(f)
-	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
-		"yaml":    cmd.FormatYaml,
-		"json":    cmd.FormatJson,
-		"tabular": formatWhoAmITabular,
-	})
+	c.out.AddFlags(f, "tabular", output.TabularFormatters(formatWhoAmITabular))
 }
 
 type whoAmI struct {
@@ -60,6 +60,7 @@ import (
 	jujunames "github.com/juju/juju/juju/names"
 	"github.com/juju/juju/juju/paths"
 	"github.com/juju/juju/mongo"
+	"github.com/juju/juju/pubsub"
 	"github.com/juju/juju/service"
 	"github.com/juju/juju/service/common"
 	"github.com/juju/juju/state"
@@ -72,7 +73,6 @@ import (
 	"github.com/juju/juju/worker"
 	"github.com/juju/juju/worker/apicaller"
 	"github.com/juju/juju/worker/certupdater"
-	"github.com/juju/juju/worker/conv2state"
 	"github.com/juju/juju/worker/dblogpruner"
 	"github.com/juju/juju/worker/dependency"
 	"github.com/juju/juju/worker/deployer"
@@ -85,6 +85,7 @@ import (
 	"github.com/juju/juju/worker/mongoupgrader"
 	"github.com/juju/juju/worker/peergrouper"
 	"github.com/juju/juju/worker/provisioner"
+	"github.com/juju/juju/worker/pubsubforwarder"
 	"github.com/juju/juju/worker/singular"
 	"github.com/juju/juju/worker/txnpruner"
 	"github.com/juju/juju/worker/upgradesteps"
@@ -115,6 +116,10 @@ var (
 // Variable to override in tests, default is true
 var ProductionMongoWriteConcern = true
 
+// apiSlowCallThreshold is the duration after which an API call is
+// logged by the controller as being slow.
+const apiSlowCallThreshold = 5 * time.Second
+
 func init() {
 	stateWorkerDialOpts = mongo.DefaultDialOpts()
 	stateWorkerDialOpts.PostDial = func(session *mgo.Session) error {
@@ -279,6 +284,7 @@ func NewMachineAgent(
 		rootDir:                     rootDir,
 		initialUpgradeCheckComplete: gate.NewLock(),
 		loopDeviceManager:           loopDeviceManager,
+		hub:                         pubsub.NewHub(names.NewMachineTag(machineId).String()),
 	}
 }
 
@@ -311,6 +317,10 @@ type MachineAgent struct {
 	mongoInitialized bool
 
 	loopDeviceManager looputil.LoopDeviceManager
+
+	// hub distributes events between the controller workers, and
+	// is shared with the other controllers by the pubsub forwarder.
+	hub *pubsub.Hub
 }
 
 // IsRestorePreparing returns bool representing if we are in restore mode
@@ -457,6 +467,10 @@ func (a *MachineAgent) makeEngineCreator(previousAgentVersion version.Number) fu
 			WorstError:  cmdutil.MoreImportantError,
 			ErrorDelay:  3 * time.Second,
 			BounceDelay: 10 * time.Millisecond,
+
+			BackoffFactor:    1.2,
+			BackoffResetTime: time.Minute,
+			MaxDelay:         2 * time.Minute,
 		}
 		engine, err := dependency.NewEngine(config)
 		if err != nil {
@@ -715,19 +729,6 @@ func (a *MachineAgent) startAPIWorkers(apiConn api.Connection) (_ worker.Worker,
 		if err := a.setControllerNetworkConfig(apiConn); err != nil {
 			return nil, errors.Annotate(err, "setting controller network config")
 		}
-	} else {
-		runner.StartWorker("stateconverter", func() (worker.Worker, error) {
-			// TODO(fwereade): this worker needs its own facade.
-			facade := apimachiner.NewState(apiConn)
-			handler := conv2state.New(facade, a)
-			w, err := watcher.NewNotifyWorker(watcher.NotifyConfig{
-				Handler: handler,
-			})
-			if err != nil {
-				return nil, errors.Annotate(err, "cannot start controller promoter worker")
-			}
-			return w, nil
-		})
 	}
 	return runner, nil
 }
@@ -751,12 +752,6 @@ func (a *MachineAgent) setControllerNetworkConfig(apiConn api.Connection) error
 	return nil
 }
 
-// Restart restarts the agent's service.
-func (a *MachineAgent) Restart() error {
-	name := a.CurrentConfig().Value(agent.AgentServiceName)
-	return service.Restart(name)
-}
-
 // openStateForUpgrade exists to be passed into the upgradesteps
 // worker. The upgradesteps worker opens state independently of the
 // state worker so that it isn't affected by the state worker's
@@ -926,7 +921,7 @@ func (a *MachineAgent) startStateWorkers(st *state.State) (worker.Worker, error)
 					return nil, errors.Annotate(err, "getting environ from state")
 				}
 				supportsSpaces := environs.SupportsSpaces(env)
-				w, err := peergrouperNew(st, supportsSpaces)
+				w, err := peergrouperNew(st, supportsSpaces, a.hub)
 				if err != nil {
 					return nil, errors.Annotate(err, "cannot start peergrouper worker")
 				}
@@ -981,6 +976,9 @@ func (a *MachineAgent) startStateWorkers(st *state.State) (worker.Worker, error)
 			a.startWorkerAfterUpgrade(runner, "certupdater", func() (worker.Worker, error) {
 				return newCertificateUpdater(m, agentConfig, st, st, stateServingSetter), nil
 			})
+			a.startWorkerAfterUpgrade(runner, "pubsubforwarder", func() (worker.Worker, error) {
+				return a.newPubSubForwarder(st)
+			})
 
 			a.startWorkerAfterUpgrade(singularRunner, "dblogpruner", func() (worker.Worker, error) {
 				return dblogpruner.New(st, dblogpruner.NewLogPruneParams()), nil
@@ -1110,6 +1108,8 @@ func (a *MachineAgent) newAPIserverWorker(st *state.State, certChanged chan para
 		AutocertURL:      controllerConfig.AutocertURL(),
 		AutocertDNSName:  controllerConfig.AutocertDNSName(),
 		AllowModelAccess: controllerConfig.AllowModelAccess(),
+		LoginRateLimit:   controllerConfig.LoginRateLimit(),
+		Hub:              a.hub,
 		NewObserver: newObserverFn(
 			controllerConfig,
 			clock.WallClock,
@@ -1126,6 +1126,31 @@ func (a *MachineAgent) newAPIserverWorker(st *state.State, certChanged chan para
 	return server, nil
 }
 
+// newPubSubForwarder returns a worker that forwards the messages
+// published on the agent's hub to the other controllers.
+func (a *MachineAgent) newPubSubForwarder(st *state.State) (worker.Worker, error) {
+	agentConfig := a.CurrentConfig()
+	info, ok := agentConfig.StateServingInfo()
+	if !ok {
+		return nil, &cmdutil.FatalError{"StateServingInfo not available and we need it"}
+	}
+	apiInfo, ok := agentConfig.APIInfo()
+	if !ok {
+		return nil, &cmdutil.FatalError{"API info not available and we need it"}
+	}
+	w, err := pubsubforwarder.New(pubsubforwarder.Config{
+		Hub:              a.hub,
+		Backend:          pubsubforwarder.NewStateBackend(st, a.machineId, info.APIPort),
+		APIInfo:          apiInfo,
+		NewMessageWriter: pubsubforwarder.NewMessageWriter,
+		ErrorDelay:       worker.RestartDelay,
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot start pubsub forwarder")
+	}
+	return w, nil
+}
+
 func newAuditEntrySink(st *state.State, logDir string) audit.AuditEntrySinkFn {
 	persistFn := st.PutAuditEntryFn()
 	fileSinkFn := audit.NewLogFileSink(logDir)
@@ -1165,8 +1190,9 @@ func newObserverFn(
 	observerFactories = append(observerFactories, func() observer.Observer {
 		logger := loggo.GetLogger("juju.apiserver")
 		ctx := observer.RequestObserverContext{
-			Clock:  clock,
-			Logger: logger,
+			Clock:             clock,
+			Logger:            logger,
+			SlowCallThreshold: apiSlowCallThreshold,
 		}
 		return observer.NewRequestObserver(ctx)
 	})
@@ -1178,6 +1204,7 @@ func newObserverFn(
 			ctx := &observer.AuditContext{
 				JujuServerVersion: jujuServerVersion,
 				ModelUUID:         modelUUID,
+				ExcludeMethods:    set.NewStrings(controllerConfig.AuditLogExcludeMethods()...),
 			}
 			return observer.NewAudit(ctx, persistAuditEntry, auditErrorHandler)
 		})
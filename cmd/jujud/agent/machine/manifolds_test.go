@@ -64,6 +64,7 @@ func (*ManifoldsSuite) TestManifoldNames(c *gc.C) {
 		"ssh-identity-writer",
 		"state",
 		"state-config-watcher",
+		"state-converter",
 		"storage-provisioner",
 		"termination-signal-handler",
 		"tools-version-checker",
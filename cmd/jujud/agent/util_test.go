@@ -33,6 +33,7 @@ import (
 	"github.com/juju/juju/mongo/mongotest"
 	"github.com/juju/juju/network"
 	"github.com/juju/juju/provider/dummy"
+	"github.com/juju/juju/pubsub"
 	"github.com/juju/juju/service/upstart"
 	"github.com/juju/juju/state"
 	coretesting "github.com/juju/juju/testing"
@@ -89,7 +90,7 @@ func (s *commonMachineSuite) SetUpTest(c *gc.C) {
 
 	s.singularRecord = newSingularRunnerRecord()
 	s.AgentSuite.PatchValue(&newSingularRunner, s.singularRecord.newSingularRunner)
-	s.AgentSuite.PatchValue(&peergrouperNew, func(*state.State, bool) (worker.Worker, error) {
+	s.AgentSuite.PatchValue(&peergrouperNew, func(*state.State, bool, *pubsub.Hub) (worker.Worker, error) {
 		return newDummyWorker(), nil
 	})
 
@@ -0,0 +1,104 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+)
+
+const (
+	// logFormatText is the default, human readable, log format.
+	logFormatText = "text"
+
+	// logFormatJSON writes one JSON object per log entry.
+	logFormatJSON = "json"
+)
+
+// logContext identifies the agent writing log entries, for formats
+// that record it alongside each entry.
+type logContext struct {
+	// entity is the tag of the agent, e.g. "machine-0".
+	entity string
+
+	// labels holds additional key/value pairs, such as the model
+	// UUID, attached to each entry.
+	labels map[string]string
+}
+
+// newLogWriter returns a loggo.Writer writing entries to target in
+// the given format. An empty format is the same as "text".
+func newLogWriter(format string, target io.Writer, ctx logContext) (loggo.Writer, error) {
+	switch format {
+	case "", logFormatText:
+		return &jujudWriter{target: target}, nil
+	case logFormatJSON:
+		return &jsonWriter{target: target, ctx: ctx}, nil
+	}
+	return nil, errors.NotValidf("log format %q", format)
+}
+
+// parseLogLabels parses comma separated key=value pairs, as rendered
+// into the agent's environment by the service package.
+func parseLogLabels(value string) (map[string]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.NotValidf("log label %q", pair)
+		}
+		labels[parts[0]] = parts[1]
+	}
+	return labels, nil
+}
+
+// jsonWriter writes log entries as JSON lines, so that they can be
+// ingested by log aggregators without parsing the text format.
+type jsonWriter struct {
+	target io.Writer
+	ctx    logContext
+}
+
+// jsonEntry is the serialised form of a log entry.
+type jsonEntry struct {
+	Timestamp string            `json:"timestamp"`
+	Entity    string            `json:"entity,omitempty"`
+	Level     string            `json:"level"`
+	Module    string            `json:"module"`
+	Location  string            `json:"location,omitempty"`
+	Message   string            `json:"message"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// Write implements loggo.Writer.
+func (w *jsonWriter) Write(entry loggo.Entry) {
+	out := jsonEntry{
+		Timestamp: entry.Timestamp.In(time.UTC).Format(time.RFC3339Nano),
+		Entity:    w.ctx.entity,
+		Level:     entry.Level.String(),
+		Module:    entry.Module,
+		Message:   entry.Message,
+		Labels:    w.ctx.labels,
+	}
+	if entry.Filename != "" {
+		out.Location = fmt.Sprintf("%s:%d", entry.Filename, entry.Line)
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		// Marshalling strings cannot fail, but never drop the
+		// message.
+		fmt.Fprintln(w.target, loggo.DefaultFormatter(entry))
+		return
+	}
+	fmt.Fprintln(w.target, string(data))
+}
@@ -0,0 +1,126 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type logWriterSuite struct{}
+
+var _ = gc.Suite(&logWriterSuite{})
+
+func (*logWriterSuite) TestNewLogWriter(c *gc.C) {
+	var buf bytes.Buffer
+	writer, err := newLogWriter("", &buf, logContext{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(writer, gc.FitsTypeOf, &jujudWriter{})
+
+	writer, err = newLogWriter("text", &buf, logContext{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(writer, gc.FitsTypeOf, &jujudWriter{})
+
+	writer, err = newLogWriter("json", &buf, logContext{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(writer, gc.FitsTypeOf, &jsonWriter{})
+
+	_, err = newLogWriter("xml", &buf, logContext{})
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	c.Assert(err, gc.ErrorMatches, `log format "xml" not valid`)
+}
+
+func (*logWriterSuite) TestJSONWriter(c *gc.C) {
+	var buf bytes.Buffer
+	writer := &jsonWriter{target: &buf}
+	timestamp := time.Date(2016, 10, 1, 12, 30, 0, 500, time.FixedZone("X", 3600))
+	writer.Write(loggo.Entry{
+		Level:     loggo.WARNING,
+		Module:    "juju.worker.uniter",
+		Filename:  "uniter.go",
+		Line:      42,
+		Timestamp: timestamp,
+		Message:   `quoted "message"`,
+	})
+	writer.Write(loggo.Entry{
+		Level:     loggo.INFO,
+		Module:    "unit.mysql/0.install",
+		Timestamp: timestamp,
+		Message:   "second",
+	})
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	c.Assert(lines, gc.HasLen, 2)
+
+	var entry map[string]interface{}
+	err := json.Unmarshal(lines[0], &entry)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entry, jc.DeepEquals, map[string]interface{}{
+		"timestamp": "2016-10-01T11:30:00.0000005Z",
+		"level":     "WARNING",
+		"module":    "juju.worker.uniter",
+		"location":  "uniter.go:42",
+		"message":   `quoted "message"`,
+	})
+
+	entry = nil
+	err = json.Unmarshal(lines[1], &entry)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entry, jc.DeepEquals, map[string]interface{}{
+		"timestamp": "2016-10-01T11:30:00.0000005Z",
+		"level":     "INFO",
+		"module":    "unit.mysql/0.install",
+		"message":   "second",
+	})
+}
+
+func (*logWriterSuite) TestJSONWriterContext(c *gc.C) {
+	var buf bytes.Buffer
+	writer, err := newLogWriter("json", &buf, logContext{
+		entity: "unit-mysql-0",
+		labels: map[string]string{"model": "deadbeef"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	writer.Write(loggo.Entry{
+		Level:     loggo.INFO,
+		Module:    "juju.worker.uniter",
+		Timestamp: time.Date(2016, 10, 1, 12, 30, 0, 0, time.UTC),
+		Message:   "hello",
+	})
+
+	var entry map[string]interface{}
+	err = json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &entry)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entry, jc.DeepEquals, map[string]interface{}{
+		"timestamp": "2016-10-01T12:30:00Z",
+		"entity":    "unit-mysql-0",
+		"level":     "INFO",
+		"module":    "juju.worker.uniter",
+		"message":   "hello",
+		"labels":    map[string]interface{}{"model": "deadbeef"},
+	})
+}
+
+func (*logWriterSuite) TestParseLogLabels(c *gc.C) {
+	labels, err := parseLogLabels("")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(labels, gc.HasLen, 0)
+
+	labels, err = parseLogLabels("model=deadbeef,region=us-east-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(labels, jc.DeepEquals, map[string]string{
+		"model":  "deadbeef",
+		"region": "us-east-1",
+	})
+
+	_, err = parseLogLabels("model")
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	c.Assert(err, gc.ErrorMatches, `log label "model" not valid`)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/cmd/jujud/dumplogs"
 	components "github.com/juju/juju/component/all"
 	"github.com/juju/juju/juju/names"
+	"github.com/juju/juju/juju/osenv"
 	"github.com/juju/juju/juju/sockets"
 	// Import the providers.
 	_ "github.com/juju/juju/provider/all"
@@ -142,8 +143,24 @@ func jujuDMain(args []string, ctx *cmd.Context) (code int, err error) {
 		Doc:  jujudDoc,
 	})
 
+	logFormat := os.Getenv(osenv.JujuLogFormatEnvKey)
+	logCtx := logContext{entity: os.Getenv(osenv.JujuLogEntityEnvKey)}
+	if labels, err := parseLogLabels(os.Getenv(osenv.JujuLogLabelsEnvKey)); err != nil {
+		logger.Warningf("ignoring %s: %v", osenv.JujuLogLabelsEnvKey, err)
+	} else {
+		logCtx.labels = labels
+	}
+	if _, err := newLogWriter(logFormat, ioutil.Discard, logCtx); err != nil {
+		// A bad format must not stop the agent; fall back to text.
+		logger.Warningf("invalid %s, using %q: %v", osenv.JujuLogFormatEnvKey, logFormatText, err)
+		logFormat = logFormatText
+	}
 	jujud.Log.NewWriter = func(target io.Writer) loggo.Writer {
-		return &jujudWriter{target: target}
+		writer, err := newLogWriter(logFormat, target, logCtx)
+		if err != nil {
+			return &jujudWriter{target: target}
+		}
+		return writer
 	}
 
 	jujud.Register(NewBootstrapCommand())
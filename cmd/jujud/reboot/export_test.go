@@ -5,6 +5,7 @@
 package reboot
 
 var (
-	Timeout = &timeout
-	TmpFile = &tmpFile
+	Timeout           = &timeout
+	TmpFile           = &tmpFile
+	RunningContainers = &runningContainers
 )
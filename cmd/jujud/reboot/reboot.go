@@ -64,9 +64,13 @@ func NewRebootWaiter(apistate api.Connection, acfg agent.Config) (*Reboot, error
 }
 
 // ExecuteReboot will wait for all running containers to stop, and then execute
-// a shutdown or a reboot (based on the action param)
+// a shutdown or a reboot (based on the action param). If the containers do not
+// stop within the timeout, the reboot or shutdown goes ahead regardless;
+// otherwise the agent would restart and wait again, forever.
 func (r *Reboot) ExecuteReboot(action params.RebootAction) error {
-	if err := r.waitForContainersOrTimeout(); err != nil {
+	if err := r.waitForContainersOrTimeout(); err == errContainersTimeout {
+		logger.Warningf("containers still running after %v, proceeding with %v", timeout, action)
+	} else if err != nil {
 		return errors.Trace(err)
 	}
 
@@ -78,9 +82,14 @@ func (r *Reboot) ExecuteReboot(action params.RebootAction) error {
 	return errors.Trace(err)
 }
 
-func (r *Reboot) runningContainers() ([]instance.Instance, error) {
+// errContainersTimeout is returned by waitForContainersOrTimeout when
+// containers are still running after the timeout.
+var errContainersTimeout = errors.New("Timeout reached waiting for containers to shutdown")
+
+// runningContainers returns the containers running on this machine
+// for the model with the given UUID.
+var runningContainers = func(modelUUID string) ([]instance.Instance, error) {
 	var runningInstances []instance.Instance
-	modelUUID := r.acfg.Model().Id()
 	for _, val := range instance.ContainerTypes {
 		managerConfig := container.ManagerConfig{
 			container.ConfigModelUUID: modelUUID}
@@ -112,7 +121,7 @@ func (r *Reboot) waitForContainersOrTimeout() error {
 				c <- nil
 				return
 			default:
-				containers, err := r.runningContainers()
+				containers, err := runningContainers(r.acfg.Model().Id())
 				if err != nil {
 					c <- err
 					return
@@ -132,7 +141,7 @@ func (r *Reboot) waitForContainersOrTimeout() error {
 		// TODO(fwereade): 2016-03-17 lp:1558657
 		// Containers are still up after timeout. C'est la vie
 		quit <- true
-		return errors.New("Timeout reached waiting for containers to shutdown")
+		return errContainersTimeout
 	case err := <-c:
 		return errors.Trace(err)
 	}
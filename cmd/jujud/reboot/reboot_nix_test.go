@@ -7,6 +7,8 @@
 package reboot_test
 
 import (
+	"errors"
+
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	ft "github.com/juju/testing/filetesting"
@@ -14,6 +16,8 @@ import (
 
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/jujud/reboot"
+	"github.com/juju/juju/instance"
+	coretesting "github.com/juju/juju/testing"
 )
 
 // on linux we use the "at" command to schedule a reboot
@@ -64,3 +68,29 @@ func (s *RebootSuite) TestShutdownNoContainers(c *gc.C) {
 	testing.AssertEchoArgs(c, rebootBin, expectedShutdownParams...)
 	ft.File{s.rebootScriptName, expectedShutdownScript, 0755}.Check(c, s.tmpDir)
 }
+
+func (s *RebootSuite) TestRebootContainersTimeout(c *gc.C) {
+	s.PatchValue(reboot.Timeout, coretesting.ShortWait)
+	s.PatchValue(reboot.RunningContainers, func(string) ([]instance.Instance, error) {
+		return []instance.Instance{nil}, nil
+	})
+	w, err := reboot.NewRebootWaiter(s.st, s.acfg)
+	c.Assert(err, jc.ErrorIsNil)
+	expectedRebootParams := s.rebootCommandParams(c)
+
+	err = w.ExecuteReboot(params.ShouldReboot)
+	c.Assert(err, jc.ErrorIsNil)
+	testing.AssertEchoArgs(c, rebootBin, expectedRebootParams...)
+	ft.File{s.rebootScriptName, expectedRebootScript, 0755}.Check(c, s.tmpDir)
+}
+
+func (s *RebootSuite) TestRebootContainersError(c *gc.C) {
+	s.PatchValue(reboot.RunningContainers, func(string) ([]instance.Instance, error) {
+		return nil, errors.New("boom")
+	})
+	w, err := reboot.NewRebootWaiter(s.st, s.acfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = w.ExecuteReboot(params.ShouldReboot)
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
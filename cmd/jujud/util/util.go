@@ -8,6 +8,7 @@ import (
 	"io"
 	"strconv"
 
+	"github.com/juju/cmd"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/utils/series"
@@ -36,7 +37,7 @@ func RequiredError(name string) error {
 func IsFatal(err error) bool {
 	err = errors.Cause(err)
 	switch err {
-	case worker.ErrTerminateAgent, worker.ErrRebootMachine, worker.ErrShutdownMachine:
+	case worker.ErrTerminateAgent, worker.ErrRestartAgent, worker.ErrRebootMachine, worker.ErrShutdownMachine:
 		return true
 	}
 
@@ -69,14 +70,16 @@ func importance(err error) int {
 		return 0
 	default:
 		return 1
-	case isUpgraded(err):
+	case err == worker.ErrRestartAgent:
 		return 2
-	case err == worker.ErrRebootMachine:
+	case isUpgraded(err):
 		return 3
+	case err == worker.ErrRebootMachine:
+		return 4
 	case err == worker.ErrShutdownMachine:
-		return 3
-	case err == worker.ErrTerminateAgent:
 		return 4
+	case err == worker.ErrTerminateAgent:
+		return 5
 	}
 }
 
@@ -94,6 +97,12 @@ func MoreImportantError(err0, err1 error) error {
 	return err1
 }
 
+// RestartAgentExitCode is the exit code of an agent process that stopped
+// because a worker returned worker.ErrRestartAgent. The init system
+// restarts agents that exit with any non-zero code; the distinct code
+// distinguishes a requested restart from a failure.
+const RestartAgentExitCode = 4
+
 // AgentDone processes the error returned by an exiting agent.
 func AgentDone(logger loggo.Logger, err error) error {
 	err = errors.Cause(err)
@@ -103,6 +112,11 @@ func AgentDone(logger loggo.Logger, err error) error {
 		// the agent process without error, to avoid the init system
 		// restarting us.
 		err = nil
+	case worker.ErrRestartAgent:
+		// The agent process must exit with an error so that the init
+		// system restarts it.
+		logger.Infof("restarting agent")
+		return cmd.NewRcPassthroughError(RestartAgentExitCode)
 	}
 	if ug, ok := err.(*upgrader.UpgradeReadyError); ok {
 		if err := ug.ChangeAgentTools(); err != nil {
@@ -6,6 +6,7 @@ package util
 import (
 	stderrors "errors"
 
+	"github.com/juju/cmd"
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -29,6 +30,7 @@ func (*toolSuite) TestErrorImportance(c *gc.C) {
 	errorImportanceTests := []error{
 		nil,
 		stderrors.New("foo"),
+		worker.ErrRestartAgent,
 		&upgrader.UpgradeReadyError{},
 		worker.ErrTerminateAgent,
 	}
@@ -53,6 +55,9 @@ var isFatalTests = []struct {
 	}, {
 		err:     errors.Trace(worker.ErrTerminateAgent),
 		isFatal: true,
+	}, {
+		err:     worker.ErrRestartAgent,
+		isFatal: true,
 	}, {
 		err:     &upgrader.UpgradeReadyError{},
 		isFatal: true,
@@ -162,6 +167,27 @@ func (*toolSuite) TestIsFatal(c *gc.C) {
 	}
 }
 
+func (*toolSuite) TestAgentDone(c *gc.C) {
+	for i, test := range []struct {
+		err    error
+		expect error
+	}{
+		{err: nil, expect: nil},
+		{err: worker.ErrTerminateAgent, expect: nil},
+		{err: errors.Trace(worker.ErrRebootMachine), expect: nil},
+		{err: worker.ErrShutdownMachine, expect: nil},
+	} {
+		c.Logf("test %d: %v", i, test.err)
+		c.Check(AgentDone(logger, test.err), gc.Equals, test.expect)
+	}
+}
+
+func (*toolSuite) TestAgentDoneRestart(c *gc.C) {
+	err := AgentDone(logger, errors.Trace(worker.ErrRestartAgent))
+	c.Assert(err, jc.Satisfies, cmd.IsRcPassthroughError)
+	c.Assert(err.(*cmd.RcPassthroughError).Code, gc.Equals, RestartAgentExitCode)
+}
+
 type testConn struct {
 	broken bool
 }
@@ -19,6 +19,17 @@ var DefaultFormatters = map[string]cmd.Formatter{
 	"json": cmd.FormatJson,
 }
 
+// TabularFormatters returns the default formatters together with
+// the given formatter, registered as "tabular".
+func TabularFormatters(tabular cmd.Formatter) map[string]cmd.Formatter {
+	formatters := make(map[string]cmd.Formatter, len(DefaultFormatters)+1)
+	for name, formatter := range DefaultFormatters {
+		formatters[name] = formatter
+	}
+	formatters["tabular"] = tabular
+	return formatters
+}
+
 // TabWriter returns a new tab writer with common layout definition.
 func TabWriter(writer io.Writer) *ansiterm.TabWriter {
 	const (
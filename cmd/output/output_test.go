@@ -0,0 +1,36 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package output_test
+
+import (
+	"bytes"
+	"io"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/output"
+)
+
+type outputSuite struct{}
+
+var _ = gc.Suite(&outputSuite{})
+
+func (s *outputSuite) TestTabularFormatters(c *gc.C) {
+	var called bool
+	formatters := output.TabularFormatters(func(io.Writer, interface{}) error {
+		called = true
+		return nil
+	})
+	c.Assert(formatters, gc.HasLen, 3)
+	c.Check(formatters["yaml"], gc.NotNil)
+	c.Check(formatters["json"], gc.NotNil)
+
+	err := formatters["tabular"](&bytes.Buffer{}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(called, jc.IsTrue)
+
+	// The defaults must not be modified.
+	c.Check(output.DefaultFormatters, gc.HasLen, 2)
+}
@@ -13,6 +13,11 @@ import (
 const (
 	ConfigModelUUID = "model-uuid"
 	ConfigLogDir    = "log-dir"
+
+	// ConfigImageMetadataURL is the key for the simplestreams URL
+	// from which container images are fetched, overriding the
+	// default (online) image sources.
+	ConfigImageMetadataURL = "image-metadata-url"
 )
 
 // ManagerConfig contains the initialization parameters for the ContainerManager.
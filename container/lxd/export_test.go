@@ -5,7 +5,16 @@
 
 package lxd
 
+import (
+	"github.com/juju/juju/container"
+	"github.com/juju/juju/tools/lxdclient"
+)
+
 var (
 	NICDevice      = nicDevice
 	NetworkDevices = networkDevices
 )
+
+func ImageSources(manager container.Manager) []lxdclient.Remote {
+	return manager.(*containerManager).imageSources
+}
@@ -32,8 +32,9 @@ const lxdDefaultProfileName = "default"
 // the local provider, so the APIs probably need to be changed to pass extra
 // args around. I'm punting for now.
 type containerManager struct {
-	modelUUID string
-	namespace instance.Namespace
+	modelUUID    string
+	namespace    instance.Namespace
+	imageSources []lxdclient.Remote
 	// A cached client.
 	client *lxdclient.Client
 }
@@ -73,10 +74,22 @@ func NewContainerManager(conf container.ManagerConfig) (container.Manager, error
 		return nil, errors.Trace(err)
 	}
 
+	imageSources := lxdclient.DefaultImageSources
+	if imageMetadataURL := conf.PopValue(container.ConfigImageMetadataURL); imageMetadataURL != "" {
+		// When a mirror is configured we use it exclusively, so that
+		// containers can be started without access to the internet.
+		imageSources = []lxdclient.Remote{{
+			Name:     imageMetadataURL,
+			Host:     imageMetadataURL,
+			Protocol: lxdclient.SimplestreamsProtocol,
+		}}
+	}
+
 	conf.WarnAboutUnused()
 	return &containerManager{
-		modelUUID: modelUUID,
-		namespace: namespace,
+		modelUUID:    modelUUID,
+		namespace:    namespace,
+		imageSources: imageSources,
 	}, nil
 }
 
@@ -109,7 +122,7 @@ func (manager *containerManager) CreateContainer(
 	}
 
 	err = manager.client.EnsureImageExists(series,
-		lxdclient.DefaultImageSources,
+		manager.imageSources,
 		func(progress string) {
 			callback(status.Provisioning, progress, nil)
 		})
@@ -52,6 +52,24 @@ func (t *LxdSuite) makeManager(c *gc.C, name string) container.Manager {
 	return manager
 }
 
+func (t *LxdSuite) TestDefaultImageSources(c *gc.C) {
+	manager := t.makeManager(c, "manager")
+	c.Assert(lxd.ImageSources(manager), jc.DeepEquals, lxdclient.DefaultImageSources)
+}
+
+func (t *LxdSuite) TestImageMetadataURL(c *gc.C) {
+	manager, err := lxd.NewContainerManager(container.ManagerConfig{
+		container.ConfigModelUUID:        testing.ModelTag.Id(),
+		container.ConfigImageMetadataURL: "https://images.example.com",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(lxd.ImageSources(manager), jc.DeepEquals, []lxdclient.Remote{{
+		Name:     "https://images.example.com",
+		Host:     "https://images.example.com",
+		Protocol: lxdclient.SimplestreamsProtocol,
+	}})
+}
+
 func (t *LxdSuite) TestNotAllContainersAreDeleted(c *gc.C) {
 	c.Skip("Test skipped because it talks directly to LXD agent.")
 	lxdClient, err := lxd.ConnectLocal()
@@ -5,6 +5,7 @@ package controller
 
 import (
 	"net/url"
+	"strings"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
@@ -25,6 +26,11 @@ const (
 	// auditing information.
 	AuditingEnabled = "auditing-enabled"
 
+	// AuditLogExcludeMethods is a comma-separated list of API methods,
+	// in "Facade.Method" form, which will not be recorded in the audit
+	// log even when auditing is enabled.
+	AuditLogExcludeMethods = "audit-log-exclude-methods"
+
 	// StatePort is the port used for mongo connections.
 	StatePort = "state-port"
 
@@ -61,6 +67,11 @@ const (
 	// they don't have any access rights to the controller itself.
 	AllowModelAccessKey = "allow-model-access"
 
+	// LoginRateLimitKey sets the number of concurrent agent Login
+	// requests the API server accepts before asking agents to try
+	// again later. If it is not set, the API server's default is used.
+	LoginRateLimitKey = "login-rate-limit"
+
 	// Attribute Defaults
 
 	// DefaultAuditingEnabled contains the default value for the
@@ -83,12 +94,15 @@ const (
 var ControllerOnlyConfigAttributes = []string{
 	AllowModelAccessKey,
 	APIPort,
+	AuditingEnabled,
+	AuditLogExcludeMethods,
 	AutocertDNSNameKey,
 	AutocertURLKey,
 	CACertKey,
 	ControllerUUIDKey,
 	IdentityPublicKey,
 	IdentityURL,
+	LoginRateLimitKey,
 	SetNUMAControlPolicyKey,
 	StatePort,
 }
@@ -181,6 +195,18 @@ func (c Config) AuditingEnabled() bool {
 	return false
 }
 
+// AuditLogExcludeMethods returns the API methods, in "Facade.Method"
+// form, which should not be recorded in the audit log.
+func (c Config) AuditLogExcludeMethods() []string {
+	var methods []string
+	for _, method := range strings.Split(c.asString(AuditLogExcludeMethods), ",") {
+		if method = strings.TrimSpace(method); method != "" {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
+
 // ControllerUUID returns the uuid for the model's controller.
 func (c Config) ControllerUUID() string {
 	return c.mustString(ControllerUUIDKey)
@@ -245,6 +271,18 @@ func (c Config) AllowModelAccess() bool {
 	return value
 }
 
+// LoginRateLimit returns the number of concurrent agent Login requests
+// the API server accepts, or 0 if the API server's default should be
+// used. See LoginRateLimitKey for more details.
+func (c Config) LoginRateLimit() int {
+	// Values obtained over the api are encoded as float64.
+	if value, ok := c[LoginRateLimitKey].(float64); ok {
+		return int(value)
+	}
+	value, _ := c[LoginRateLimitKey].(int)
+	return value
+}
+
 // Validate ensures that config is a valid configuration.
 func Validate(c Config) error {
 	if v, ok := c[IdentityPublicKey].(string); ok {
@@ -276,6 +314,10 @@ func Validate(c Config) error {
 		return errors.Annotate(err, "bad CA certificate in configuration")
 	}
 
+	if c.LoginRateLimit() < 0 {
+		return errors.Errorf("%s: must not be negative", LoginRateLimitKey)
+	}
+
 	if uuid, ok := c[ControllerUUIDKey].(string); ok && !utils.IsValidUUIDString(uuid) {
 		return errors.Errorf("controller-uuid: expected UUID, got string(%q)", uuid)
 	}
@@ -291,6 +333,7 @@ func GenerateControllerCertAndKey(caCert, caKey string, hostAddresses []string)
 
 var configChecker = schema.FieldMap(schema.Fields{
 	AuditingEnabled:         schema.Bool(),
+	AuditLogExcludeMethods:  schema.String(),
 	APIPort:                 schema.ForceInt(),
 	StatePort:               schema.ForceInt(),
 	IdentityURL:             schema.String(),
@@ -299,9 +342,11 @@ var configChecker = schema.FieldMap(schema.Fields{
 	AutocertURLKey:          schema.String(),
 	AutocertDNSNameKey:      schema.String(),
 	AllowModelAccessKey:     schema.Bool(),
+	LoginRateLimitKey:       schema.ForceInt(),
 }, schema.Defaults{
 	APIPort:                 DefaultAPIPort,
 	AuditingEnabled:         DefaultAuditingEnabled,
+	AuditLogExcludeMethods:  schema.Omit,
 	StatePort:               DefaultStatePort,
 	IdentityURL:             schema.Omit,
 	IdentityPublicKey:       schema.Omit,
@@ -309,4 +354,5 @@ var configChecker = schema.FieldMap(schema.Fields{
 	AutocertURLKey:          schema.Omit,
 	AutocertDNSNameKey:      schema.Omit,
 	AllowModelAccessKey:     schema.Omit,
+	LoginRateLimitKey:       schema.Omit,
 })
@@ -120,6 +120,13 @@ var validateTests = []struct {
 		controller.CACertKey:         testing.CACert,
 	},
 	expectError: `invalid identity public key: wrong length for base64 key, got 3 want 32`,
+}, {
+	about: "negative login rate limit",
+	config: controller.Config{
+		controller.LoginRateLimitKey: -1,
+		controller.CACertKey:         testing.CACert,
+	},
+	expectError: `login-rate-limit: must not be negative`,
 }}
 
 func (s *ConfigSuite) TestValidate(c *gc.C) {
@@ -133,3 +140,46 @@ func (s *ConfigSuite) TestValidate(c *gc.C) {
 		}
 	}
 }
+
+func (s *ConfigSuite) TestAuditLogExcludeMethods(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, map[string]interface{}{
+		controller.AuditLogExcludeMethods: "Client.FullStatus, ModelManager.ListModels,",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.AuditLogExcludeMethods(), jc.DeepEquals, []string{
+		"Client.FullStatus",
+		"ModelManager.ListModels",
+	})
+}
+
+func (s *ConfigSuite) TestAuditLogExcludeMethodsDefault(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.AuditLogExcludeMethods(), gc.HasLen, 0)
+}
+
+func (s *ConfigSuite) TestLoginRateLimit(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, map[string]interface{}{
+		controller.LoginRateLimitKey: "20",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.LoginRateLimit(), gc.Equals, 20)
+
+	// Values obtained over the API are float64.
+	cfg[controller.LoginRateLimitKey] = float64(30)
+	c.Assert(cfg.LoginRateLimit(), gc.Equals, 30)
+}
+
+func (s *ConfigSuite) TestLoginRateLimitDefault(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.LoginRateLimit(), gc.Equals, 0)
+}
+
+func (s *ConfigSuite) TestControllerOnlyAttributes(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	for name := range cfg {
+		c.Check(controller.ControllerOnlyAttribute(name), jc.IsTrue, gc.Commentf("%s", name))
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package lxdprofile reads and validates the LXD profiles that charms
+// may ship as lxd-profile.yaml, and creates them on an LXD server so
+// they can be applied to the containers hosting the charms' units.
+//
+// Applying the profiles to running containers, and updating them when
+// a charm is upgraded, is left to a worker that is yet to be written.
+package lxdprofile
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/utils/set"
+	"gopkg.in/yaml.v2"
+)
+
+var logger = loggo.GetLogger("juju.core.lxdprofile")
+
+// Filename is the name of the file, at the top level of a charm,
+// holding the charm's LXD profile.
+const Filename = "lxd-profile.yaml"
+
+// Prefix is prepended to the names of all profiles created by juju.
+const Prefix = "juju-"
+
+// allowedDeviceTypes holds the types of device a charm's profile may
+// pass through to a container.
+var allowedDeviceTypes = set.NewStrings("unix-char", "unix-block", "gpu", "usb")
+
+// disallowedConfigPrefixes holds the prefixes of the container config
+// keys that juju manages itself, and so a charm's profile may not set.
+var disallowedConfigPrefixes = []string{"boot.", "limits.", "migration."}
+
+// Profile is a charm's LXD profile.
+type Profile struct {
+	Description string                       `yaml:"description,omitempty"`
+	Config      map[string]string            `yaml:"config,omitempty"`
+	Devices     map[string]map[string]string `yaml:"devices,omitempty"`
+}
+
+// Read reads and validates a profile in lxd-profile.yaml format.
+func Read(r io.Reader) (*Profile, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Annotatef(err, "reading %s", Filename)
+	}
+	var profile Profile
+	if err := yaml.Unmarshal(data, &profile); err != nil {
+		return nil, errors.Annotatef(err, "parsing %s", Filename)
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &profile, nil
+}
+
+// ReadCharmDir reads the profile of the expanded charm in the given
+// directory. It returns an error satisfying errors.IsNotFound if the
+// charm has no profile.
+func ReadCharmDir(dir string) (*Profile, error) {
+	f, err := os.Open(filepath.Join(dir, Filename))
+	if os.IsNotExist(err) {
+		return nil, errors.NotFoundf("%s in charm %q", Filename, dir)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// ReadCharmArchive reads the profile of the charm archive at the given
+// path. It returns an error satisfying errors.IsNotFound if the charm
+// has no profile.
+func ReadCharmArchive(path string) (*Profile, error) {
+	zipr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, errors.Annotatef(err, "opening charm archive %q", path)
+	}
+	defer zipr.Close()
+	for _, file := range zipr.File {
+		if file.Name != Filename {
+			continue
+		}
+		f, err := file.Open()
+		if err != nil {
+			return nil, errors.Annotatef(err, "opening %s", Filename)
+		}
+		defer f.Close()
+		return Read(f)
+	}
+	return nil, errors.NotFoundf("%s in charm archive %q", Filename, path)
+}
+
+// Empty reports whether the profile neither sets config nor adds
+// devices, and so need not be applied.
+func (p *Profile) Empty() bool {
+	return len(p.Config) == 0 && len(p.Devices) == 0
+}
+
+// Validate returns an error if the profile sets config juju manages
+// itself, or adds a device of a type that may not be passed through.
+func (p *Profile) Validate() error {
+	for name, device := range p.Devices {
+		deviceType, ok := device["type"]
+		if !ok {
+			return errors.NotValidf("%s device %q without type", Filename, name)
+		}
+		if !allowedDeviceTypes.Contains(deviceType) {
+			return errors.NotValidf("%s device %q of type %q", Filename, name, deviceType)
+		}
+	}
+	for key := range p.Config {
+		for _, prefix := range disallowedConfigPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				return errors.NotValidf("%s config %q", Filename, key)
+			}
+		}
+	}
+	return nil
+}
+
+// Name returns the name of the LXD profile for revision of the named
+// application's charm, in the named model.
+func Name(modelName, applicationName string, revision int) string {
+	return fmt.Sprintf("%s%s-%s-%d", Prefix, modelName, applicationName, revision)
+}
+
+// ProfileManager is the subset of an LXD client's methods needed to
+// create a profile.
+type ProfileManager interface {
+	// HasProfile reports whether the named profile exists.
+	HasProfile(name string) (bool, error)
+
+	// CreateProfile creates the named profile with the given config.
+	CreateProfile(name string, config map[string]string) error
+
+	// AddProfileDevice adds the named device to the profile. The
+	// device's "type" entry gives its type; the others its properties.
+	AddProfileDevice(profile, name string, device map[string]string) error
+
+	// ProfileDelete deletes the named profile.
+	ProfileDelete(name string) error
+}
+
+// Apply creates the named profile using the given manager, unless it
+// already exists. Profile names include the charm revision, so an
+// existing profile is taken to be up to date. If the profile cannot be
+// completed it is deleted again, so that a later Apply starts afresh.
+func Apply(manager ProfileManager, name string, profile *Profile) (err error) {
+	if err := profile.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	exists, err := manager.HasProfile(name)
+	if err != nil {
+		return errors.Annotatef(err, "checking for LXD profile %q", name)
+	}
+	if exists {
+		return nil
+	}
+	// CreateProfile may fail after creating the profile, while
+	// setting its config, so clean up after it too.
+	defer func() {
+		if err == nil {
+			return
+		}
+		if deleteErr := manager.ProfileDelete(name); deleteErr != nil {
+			logger.Errorf("cannot delete incomplete LXD profile %q: %v", name, deleteErr)
+		}
+	}()
+	if err := manager.CreateProfile(name, profile.Config); err != nil {
+		return errors.Annotatef(err, "creating LXD profile %q", name)
+	}
+	deviceNames := make([]string, 0, len(profile.Devices))
+	for deviceName := range profile.Devices {
+		deviceNames = append(deviceNames, deviceName)
+	}
+	sort.Strings(deviceNames)
+	for _, deviceName := range deviceNames {
+		if err := manager.AddProfileDevice(name, deviceName, profile.Devices[deviceName]); err != nil {
+			return errors.Annotatef(err, "adding device %q to LXD profile %q", deviceName, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package lxdprofile_test
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/lxdprofile"
+)
+
+type profileSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&profileSuite{})
+
+func (*profileSuite) TestRead(c *gc.C) {
+	profile, err := lxdprofile.Read(strings.NewReader(`
+description: sample profile
+config:
+  security.nesting: "true"
+  linux.kernel_modules: openvswitch,nbd,ip_tables
+devices:
+  tun:
+    path: /dev/net/tun
+    type: unix-char
+`))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(profile, jc.DeepEquals, &lxdprofile.Profile{
+		Description: "sample profile",
+		Config: map[string]string{
+			"security.nesting":     "true",
+			"linux.kernel_modules": "openvswitch,nbd,ip_tables",
+		},
+		Devices: map[string]map[string]string{
+			"tun": {"path": "/dev/net/tun", "type": "unix-char"},
+		},
+	})
+	c.Assert(profile.Empty(), jc.IsFalse)
+}
+
+func (*profileSuite) TestReadEmpty(c *gc.C) {
+	profile, err := lxdprofile.Read(strings.NewReader("description: nothing to see"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(profile.Empty(), jc.IsTrue)
+}
+
+func (*profileSuite) TestReadBadYAML(c *gc.C) {
+	_, err := lxdprofile.Read(strings.NewReader("config: [}"))
+	c.Assert(err, gc.ErrorMatches, "parsing lxd-profile.yaml: .*")
+}
+
+func (*profileSuite) TestValidate(c *gc.C) {
+	for i, test := range []struct {
+		profile lxdprofile.Profile
+		err     string
+	}{{
+		profile: lxdprofile.Profile{Config: map[string]string{"boot.autostart": "true"}},
+		err:     `lxd-profile.yaml config "boot.autostart" not valid`,
+	}, {
+		profile: lxdprofile.Profile{Config: map[string]string{"limits.memory": "1GB"}},
+		err:     `lxd-profile.yaml config "limits.memory" not valid`,
+	}, {
+		profile: lxdprofile.Profile{Config: map[string]string{"migration.incremental.memory": "true"}},
+		err:     `lxd-profile.yaml config "migration.incremental.memory" not valid`,
+	}, {
+		profile: lxdprofile.Profile{Devices: map[string]map[string]string{
+			"root": {"type": "disk", "path": "/"},
+		}},
+		err: `lxd-profile.yaml device "root" of type "disk" not valid`,
+	}, {
+		profile: lxdprofile.Profile{Devices: map[string]map[string]string{
+			"tun": {"path": "/dev/net/tun"},
+		}},
+		err: `lxd-profile.yaml device "tun" without type not valid`,
+	}} {
+		c.Logf("test %d: %s", i, test.err)
+		err := test.profile.Validate()
+		c.Check(err, jc.Satisfies, errors.IsNotValid)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (*profileSuite) TestValidateAllowed(c *gc.C) {
+	profile := lxdprofile.Profile{
+		Config: map[string]string{
+			"security.privileged": "true",
+			// Only whole namespaces are reserved.
+			"bootstrap.x": "y",
+		},
+		Devices: map[string]map[string]string{
+			"a": {"type": "unix-char"},
+			"b": {"type": "unix-block"},
+			"c": {"type": "gpu"},
+			"d": {"type": "usb"},
+		},
+	}
+	c.Assert(profile.Validate(), jc.ErrorIsNil)
+}
+
+func (*profileSuite) TestName(c *gc.C) {
+	c.Assert(lxdprofile.Name("default", "lxd-profile", 3), gc.Equals, "juju-default-lxd-profile-3")
+}
+
+const sampleProfile = `
+config:
+  security.nesting: "true"
+devices:
+  tun:
+    path: /dev/net/tun
+    type: unix-char
+`
+
+func (*profileSuite) TestReadCharmDir(c *gc.C) {
+	dir := c.MkDir()
+	err := ioutil.WriteFile(filepath.Join(dir, "lxd-profile.yaml"), []byte(sampleProfile), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	profile, err := lxdprofile.ReadCharmDir(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(profile.Config, jc.DeepEquals, map[string]string{"security.nesting": "true"})
+}
+
+func (*profileSuite) TestReadCharmDirNoProfile(c *gc.C) {
+	_, err := lxdprofile.ReadCharmDir(c.MkDir())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (*profileSuite) TestReadCharmArchive(c *gc.C) {
+	path := writeArchive(c, map[string]string{
+		"metadata.yaml":    "name: lxd-profile",
+		"lxd-profile.yaml": sampleProfile,
+	})
+	profile, err := lxdprofile.ReadCharmArchive(path)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(profile.Devices, jc.DeepEquals, map[string]map[string]string{
+		"tun": {"path": "/dev/net/tun", "type": "unix-char"},
+	})
+}
+
+func (*profileSuite) TestReadCharmArchiveNoProfile(c *gc.C) {
+	path := writeArchive(c, map[string]string{"metadata.yaml": "name: plain"})
+	_, err := lxdprofile.ReadCharmArchive(path)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func writeArchive(c *gc.C, files map[string]string) string {
+	path := filepath.Join(c.MkDir(), "charm.zip")
+	f, err := os.Create(path)
+	c.Assert(err, jc.ErrorIsNil)
+	defer f.Close()
+	zipw := zip.NewWriter(f)
+	for name, content := range files {
+		w, err := zipw.Create(name)
+		c.Assert(err, jc.ErrorIsNil)
+		_, err = w.Write([]byte(content))
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	c.Assert(zipw.Close(), jc.ErrorIsNil)
+	return path
+}
+
+func (*profileSuite) TestApply(c *gc.C) {
+	var manager fakeProfileManager
+	profile := &lxdprofile.Profile{
+		Config: map[string]string{"security.nesting": "true"},
+		Devices: map[string]map[string]string{
+			"tun": {"path": "/dev/net/tun", "type": "unix-char"},
+			"gpu": {"type": "gpu"},
+		},
+	}
+	err := lxdprofile.Apply(&manager, "juju-default-app-1", profile)
+	c.Assert(err, jc.ErrorIsNil)
+	manager.stub.CheckCalls(c, []testing.StubCall{
+		{"HasProfile", []interface{}{"juju-default-app-1"}},
+		{"CreateProfile", []interface{}{"juju-default-app-1", profile.Config}},
+		{"AddProfileDevice", []interface{}{"juju-default-app-1", "gpu", profile.Devices["gpu"]}},
+		{"AddProfileDevice", []interface{}{"juju-default-app-1", "tun", profile.Devices["tun"]}},
+	})
+}
+
+func (*profileSuite) TestApplyExisting(c *gc.C) {
+	manager := fakeProfileManager{exists: true}
+	err := lxdprofile.Apply(&manager, "juju-default-app-1", &lxdprofile.Profile{})
+	c.Assert(err, jc.ErrorIsNil)
+	manager.stub.CheckCallNames(c, "HasProfile")
+}
+
+func (*profileSuite) TestApplyInvalid(c *gc.C) {
+	var manager fakeProfileManager
+	profile := &lxdprofile.Profile{Config: map[string]string{"limits.cpu": "2"}}
+	err := lxdprofile.Apply(&manager, "juju-default-app-1", profile)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	manager.stub.CheckNoCalls(c)
+}
+
+func (*profileSuite) TestApplyError(c *gc.C) {
+	var manager fakeProfileManager
+	manager.stub.SetErrors(nil, errors.New("boom"))
+	err := lxdprofile.Apply(&manager, "juju-default-app-1", &lxdprofile.Profile{})
+	c.Assert(err, gc.ErrorMatches, `creating LXD profile "juju-default-app-1": boom`)
+	manager.stub.CheckCallNames(c, "HasProfile", "CreateProfile", "ProfileDelete")
+}
+
+func (*profileSuite) TestApplyDeviceErrorDeletesProfile(c *gc.C) {
+	var manager fakeProfileManager
+	manager.stub.SetErrors(nil, nil, errors.New("boom"))
+	profile := &lxdprofile.Profile{
+		Devices: map[string]map[string]string{
+			"tun": {"path": "/dev/net/tun", "type": "unix-char"},
+		},
+	}
+	err := lxdprofile.Apply(&manager, "juju-default-app-1", profile)
+	c.Assert(err, gc.ErrorMatches, `adding device "tun" to LXD profile "juju-default-app-1": boom`)
+	manager.stub.CheckCallNames(c, "HasProfile", "CreateProfile", "AddProfileDevice", "ProfileDelete")
+	manager.stub.CheckCall(c, 3, "ProfileDelete", "juju-default-app-1")
+}
+
+type fakeProfileManager struct {
+	stub   testing.Stub
+	exists bool
+}
+
+func (m *fakeProfileManager) HasProfile(name string) (bool, error) {
+	m.stub.AddCall("HasProfile", name)
+	return m.exists, m.stub.NextErr()
+}
+
+func (m *fakeProfileManager) CreateProfile(name string, config map[string]string) error {
+	m.stub.AddCall("CreateProfile", name, config)
+	return m.stub.NextErr()
+}
+
+func (m *fakeProfileManager) AddProfileDevice(profile, name string, device map[string]string) error {
+	m.stub.AddCall("AddProfileDevice", profile, name, device)
+	return m.stub.NextErr()
+}
+
+func (m *fakeProfileManager) ProfileDelete(name string) error {
+	m.stub.AddCall("ProfileDelete", name)
+	return m.stub.NextErr()
+}
@@ -0,0 +1,128 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package raftlease
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/utils/clock"
+
+	"github.com/juju/juju/core/lease"
+)
+
+// Applier submits commands to the replicated log. Apply must not
+// return until the command has been applied to the local FSM, and
+// must return the error from FSM.Apply unchanged.
+type Applier interface {
+	Apply(command Command) error
+}
+
+// ClientConfig holds the resources and configuration needed to
+// create a Client.
+type ClientConfig struct {
+	// Namespace is the namespace of the leases managed by the client.
+	Namespace string
+
+	// FSM is the local replica of the lease state.
+	FSM *FSM
+
+	// Applier submits lease commands to the replicated log.
+	Applier Applier
+
+	// Clock is used to express lease expiry times in local time.
+	Clock clock.Clock
+}
+
+// Validate returns an error if the configuration is invalid.
+func (config ClientConfig) Validate() error {
+	if err := lease.ValidateString(config.Namespace); err != nil {
+		return errors.NewNotValid(err, "invalid Namespace")
+	}
+	if config.FSM == nil {
+		return errors.NotValidf("nil FSM")
+	}
+	if config.Applier == nil {
+		return errors.NotValidf("nil Applier")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	return nil
+}
+
+// Client implements lease.Client on top of a replicated FSM.
+type Client struct {
+	config ClientConfig
+}
+
+// NewClient returns a new Client using the supplied config.
+func NewClient(config ClientConfig) (*Client, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &Client{config: config}, nil
+}
+
+// ClaimLease is part of the lease.Client interface.
+func (client *Client) ClaimLease(name string, request lease.Request) error {
+	return client.apply(Command{
+		Operation: OperationClaim,
+		Lease:     name,
+		Holder:    request.Holder,
+		Duration:  request.Duration,
+	})
+}
+
+// ExtendLease is part of the lease.Client interface.
+func (client *Client) ExtendLease(name string, request lease.Request) error {
+	return client.apply(Command{
+		Operation: OperationExtend,
+		Lease:     name,
+		Holder:    request.Holder,
+		Duration:  request.Duration,
+	})
+}
+
+// ExpireLease is part of the lease.Client interface.
+func (client *Client) ExpireLease(name string) error {
+	return client.apply(Command{
+		Operation: OperationExpire,
+		Lease:     name,
+	})
+}
+
+// apply fills in the command's version and namespace and submits it,
+// passing lease.ErrInvalid through unwrapped as the lease manager
+// expects.
+func (client *Client) apply(command Command) error {
+	command.Version = CommandVersion
+	command.Namespace = client.config.Namespace
+	if err := command.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	err := client.config.Applier.Apply(command)
+	if errors.Cause(err) == lease.ErrInvalid {
+		return lease.ErrInvalid
+	}
+	return errors.Trace(err)
+}
+
+// Leases is part of the lease.Client interface. The FSM records
+// expiry times in global time; they are translated into local time by
+// adding each lease's remaining global duration to the current time.
+func (client *Client) Leases() map[string]lease.Info {
+	globalTime := client.config.FSM.GlobalTime()
+	now := client.config.Clock.Now()
+	leases := client.config.FSM.Leases(client.config.Namespace)
+	for name, info := range leases {
+		info.Expiry = now.Add(info.Expiry.Sub(globalTime))
+		leases[name] = info
+	}
+	return leases
+}
+
+// Refresh is part of the lease.Client interface. The FSM is a local
+// replica kept up to date by the log, so there is nothing to read.
+func (client *Client) Refresh() error {
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package raftlease_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/lease"
+	"github.com/juju/juju/core/raftlease"
+)
+
+type clientSuite struct {
+	testing.IsolationSuite
+	fsm    *raftlease.FSM
+	clock  *testing.Clock
+	client *raftlease.Client
+}
+
+var _ = gc.Suite(&clientSuite{})
+
+func (s *clientSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.fsm = raftlease.NewFSM()
+	s.clock = testing.NewClock(time.Date(2016, 10, 1, 0, 0, 0, 0, time.UTC))
+	client, err := raftlease.NewClient(raftlease.ClientConfig{
+		Namespace: "ns",
+		FSM:       s.fsm,
+		Applier:   s.fsm,
+		Clock:     s.clock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.client = client
+}
+
+func (s *clientSuite) TestValidate(c *gc.C) {
+	for i, test := range []struct {
+		config raftlease.ClientConfig
+		err    string
+	}{{
+		raftlease.ClientConfig{},
+		"invalid Namespace: string is empty",
+	}, {
+		raftlease.ClientConfig{Namespace: "ns"},
+		"nil FSM not valid",
+	}, {
+		raftlease.ClientConfig{Namespace: "ns", FSM: s.fsm},
+		"nil Applier not valid",
+	}, {
+		raftlease.ClientConfig{Namespace: "ns", FSM: s.fsm, Applier: s.fsm},
+		"nil Clock not valid",
+	}} {
+		c.Logf("test %d", i)
+		client, err := raftlease.NewClient(test.config)
+		c.Check(err, jc.Satisfies, errors.IsNotValid)
+		c.Check(err, gc.ErrorMatches, test.err)
+		c.Check(client, gc.IsNil)
+	}
+}
+
+func (s *clientSuite) TestClaimLease(c *gc.C) {
+	err := s.client.ClaimLease("lease", lease.Request{Holder: "holder", Duration: time.Minute})
+	c.Assert(err, jc.ErrorIsNil)
+
+	leases := s.client.Leases()
+	c.Assert(leases, gc.HasLen, 1)
+	c.Assert(leases["lease"].Holder, gc.Equals, "holder")
+	c.Assert(leases["lease"].Expiry, gc.Equals, s.clock.Now().Add(time.Minute))
+}
+
+func (s *clientSuite) TestClaimHeldLease(c *gc.C) {
+	err := s.client.ClaimLease("lease", lease.Request{Holder: "holder", Duration: time.Minute})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.client.ClaimLease("lease", lease.Request{Holder: "other", Duration: time.Minute})
+	c.Assert(err, gc.Equals, lease.ErrInvalid)
+}
+
+func (s *clientSuite) TestExtendLease(c *gc.C) {
+	err := s.client.ClaimLease("lease", lease.Request{Holder: "holder", Duration: time.Minute})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.client.ExtendLease("lease", lease.Request{Holder: "holder", Duration: time.Hour})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.client.Leases()["lease"].Expiry, gc.Equals, s.clock.Now().Add(time.Hour))
+
+	err = s.client.ExtendLease("lease", lease.Request{Holder: "other", Duration: time.Hour})
+	c.Assert(err, gc.Equals, lease.ErrInvalid)
+}
+
+func (s *clientSuite) TestExpireLease(c *gc.C) {
+	err := s.client.ClaimLease("lease", lease.Request{Holder: "holder", Duration: time.Minute})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.client.ExpireLease("lease")
+	c.Assert(err, gc.Equals, lease.ErrInvalid)
+
+	s.advanceGlobalTime(c, time.Minute)
+	err = s.client.ExpireLease("lease")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.client.Leases(), gc.HasLen, 0)
+}
+
+func (s *clientSuite) TestLeasesUseLocalTime(c *gc.C) {
+	err := s.client.ClaimLease("lease", lease.Request{Holder: "holder", Duration: time.Minute})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Global time has moved on by 20s, so 40s of the lease remain
+	// wherever the local clock happens to be.
+	s.advanceGlobalTime(c, 20*time.Second)
+	s.clock.Advance(time.Hour)
+	c.Assert(s.client.Leases()["lease"].Expiry, gc.Equals, s.clock.Now().Add(40*time.Second))
+}
+
+func (s *clientSuite) TestInvalidLeaseName(c *gc.C) {
+	err := s.client.ClaimLease("bad name", lease.Request{Holder: "holder", Duration: time.Minute})
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	c.Assert(s.client.Leases(), gc.HasLen, 0)
+}
+
+func (s *clientSuite) TestRefresh(c *gc.C) {
+	c.Assert(s.client.Refresh(), jc.ErrorIsNil)
+}
+
+func (s *clientSuite) advanceGlobalTime(c *gc.C, d time.Duration) {
+	old := s.fsm.GlobalTime()
+	err := s.fsm.Apply(raftlease.Command{
+		Version:   raftlease.CommandVersion,
+		Operation: raftlease.OperationSetTime,
+		OldTime:   old,
+		NewTime:   old.Add(d),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
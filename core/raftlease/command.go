@@ -0,0 +1,128 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package raftlease
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/lease"
+)
+
+const (
+	// CommandVersion is the current version of the command format. It
+	// must be incremented whenever the meaning of a command changes, so
+	// that log entries written by other controllers can be rejected
+	// rather than misapplied.
+	CommandVersion = 1
+
+	// OperationClaim denotes claiming a new lease.
+	OperationClaim = "claim"
+
+	// OperationExtend denotes extending an already-held lease.
+	OperationExtend = "extend"
+
+	// OperationExpire denotes removing a lease whose expiry time has
+	// passed.
+	OperationExpire = "expire"
+
+	// OperationSetTime denotes advancing the global time that all
+	// lease expiries are measured against.
+	OperationSetTime = "setTime"
+)
+
+// Command captures the details of an operation to be applied to the
+// FSM. Commands are serialised into the replicated log, so every
+// controller applies exactly the same sequence of them.
+type Command struct {
+	// Version of the command format, in case it changes and we need
+	// to handle multiple formats.
+	Version int `json:"version"`
+
+	// Operation is one of claim, extend, expire or setTime.
+	Operation string `json:"operation"`
+
+	// Namespace is the kind of lease, for example "application-leadership".
+	Namespace string `json:"namespace,omitempty"`
+
+	// Lease is the name of the lease the command affects.
+	Lease string `json:"lease,omitempty"`
+
+	// Holder is the name of the party claiming or extending the lease.
+	Holder string `json:"holder,omitempty"`
+
+	// Duration is how long the lease should last.
+	Duration time.Duration `json:"duration,omitempty"`
+
+	// OldTime is the global time the setTime command was based on.
+	OldTime time.Time `json:"old-time"`
+
+	// NewTime is the global time to advance to.
+	NewTime time.Time `json:"new-time"`
+}
+
+// Validate returns an error if the command is malformed.
+func (c *Command) Validate() error {
+	if c.Version != CommandVersion {
+		return errors.NotValidf("version %d", c.Version)
+	}
+	switch c.Operation {
+	case OperationClaim, OperationExtend:
+		if err := c.validateLease(); err != nil {
+			return errors.Trace(err)
+		}
+		request := lease.Request{Holder: c.Holder, Duration: c.Duration}
+		if err := request.Validate(); err != nil {
+			return errors.NewNotValid(err, c.Operation+" command")
+		}
+	case OperationExpire:
+		if err := c.validateLease(); err != nil {
+			return errors.Trace(err)
+		}
+		if c.Holder != "" || c.Duration != 0 {
+			return errors.NotValidf("expire command with holder or duration")
+		}
+	case OperationSetTime:
+		if c.Namespace != "" || c.Lease != "" || c.Holder != "" || c.Duration != 0 {
+			return errors.NotValidf("setTime command with lease details")
+		}
+		if !c.NewTime.After(c.OldTime) {
+			return errors.NotValidf("setTime command not advancing time")
+		}
+	default:
+		return errors.NotValidf("operation %q", c.Operation)
+	}
+	return nil
+}
+
+func (c *Command) validateLease() error {
+	if err := lease.ValidateString(c.Namespace); err != nil {
+		return errors.NewNotValid(err, c.Operation+" command: invalid namespace")
+	}
+	if err := lease.ValidateString(c.Lease); err != nil {
+		return errors.NewNotValid(err, c.Operation+" command: invalid lease")
+	}
+	return nil
+}
+
+// Marshal converts this command to a byte slice suitable for storing
+// in the replicated log.
+func (c *Command) Marshal() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+// UnmarshalCommand converts a marshalled command back into a Command,
+// validating it in the process.
+func UnmarshalCommand(data []byte) (*Command, error) {
+	var result Command
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := result.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package raftlease_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/raftlease"
+)
+
+type commandSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&commandSuite{})
+
+func (*commandSuite) TestMarshalRoundTrip(c *gc.C) {
+	now := time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i, command := range []raftlease.Command{
+		leaseCommand(raftlease.OperationClaim, "holder", time.Minute),
+		leaseCommand(raftlease.OperationExtend, "holder", time.Minute),
+		leaseCommand(raftlease.OperationExpire, "", 0),
+		{
+			Version:   raftlease.CommandVersion,
+			Operation: raftlease.OperationSetTime,
+			OldTime:   now,
+			NewTime:   now.Add(time.Second),
+		},
+	} {
+		c.Logf("test %d: %s", i, command.Operation)
+		data, err := command.Marshal()
+		c.Assert(err, jc.ErrorIsNil)
+		result, err := raftlease.UnmarshalCommand(data)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(result.Version, gc.Equals, command.Version)
+		c.Check(result.Operation, gc.Equals, command.Operation)
+		c.Check(result.Namespace, gc.Equals, command.Namespace)
+		c.Check(result.Lease, gc.Equals, command.Lease)
+		c.Check(result.Holder, gc.Equals, command.Holder)
+		c.Check(result.Duration, gc.Equals, command.Duration)
+		c.Check(result.OldTime.Equal(command.OldTime), jc.IsTrue)
+		c.Check(result.NewTime.Equal(command.NewTime), jc.IsTrue)
+	}
+}
+
+func (*commandSuite) TestUnmarshalInvalid(c *gc.C) {
+	_, err := raftlease.UnmarshalCommand([]byte(`{"version": 2, "operation": "claim"}`))
+	c.Assert(err, gc.ErrorMatches, "version 2 not valid")
+}
+
+func (*commandSuite) TestValidate(c *gc.C) {
+	now := time.Date(2016, 10, 1, 12, 0, 0, 0, time.UTC)
+	badHolder := leaseCommand(raftlease.OperationClaim, "", time.Minute)
+	badDuration := leaseCommand(raftlease.OperationExtend, "holder", 0)
+	badLease := leaseCommand(raftlease.OperationClaim, "holder", time.Minute)
+	badLease.Lease = "le ase"
+	expireHolder := leaseCommand(raftlease.OperationExpire, "holder", 0)
+	for i, test := range []struct {
+		command raftlease.Command
+		err     string
+	}{{
+		command: badHolder,
+		err:     "claim command: invalid holder: string is empty",
+	}, {
+		command: badDuration,
+		err:     "extend command: invalid duration",
+	}, {
+		command: badLease,
+		err:     "claim command: invalid lease: string contains forbidden characters",
+	}, {
+		command: expireHolder,
+		err:     "expire command with holder or duration not valid",
+	}, {
+		command: raftlease.Command{
+			Version:   raftlease.CommandVersion,
+			Operation: raftlease.OperationSetTime,
+			OldTime:   now,
+			NewTime:   now,
+		},
+		err: "setTime command not advancing time not valid",
+	}, {
+		command: raftlease.Command{
+			Version:   raftlease.CommandVersion,
+			Operation: raftlease.OperationSetTime,
+			Lease:     "lease",
+			OldTime:   now,
+			NewTime:   now.Add(time.Second),
+		},
+		err: "setTime command with lease details not valid",
+	}} {
+		c.Logf("test %d: %s", i, test.err)
+		err := test.command.Validate()
+		c.Check(err, jc.Satisfies, errors.IsNotValid)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
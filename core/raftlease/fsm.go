@@ -0,0 +1,156 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package raftlease holds the finite state machine for leases that are
+// replicated across controller machines by a consensus log rather than
+// stored in MongoDB.
+//
+// The FSM never consults a local clock: lease expiry is measured
+// against a global time that only advances through setTime commands in
+// the log. Every controller applying the same log therefore agrees on
+// which leases have expired, even across failover.
+//
+// Client implements lease.Client over an FSM, submitting commands
+// through an Applier. Wiring the FSM into an embedded raft node, which
+// would provide that Applier, is not yet done; until then the MongoDB
+// lease client in state/lease remains in use.
+package raftlease
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/lease"
+)
+
+// ErrTimeMismatch indicates that a setTime command was based on a
+// global time other than the current one; the caller should read the
+// new global time and try again.
+var ErrTimeMismatch = errors.New("global time mismatch")
+
+// key identifies a lease within the FSM.
+type key struct {
+	namespace string
+	lease     string
+}
+
+// entry holds the current state of a lease.
+type entry struct {
+	holder   string
+	start    time.Time
+	duration time.Duration
+}
+
+func (e *entry) expiry() time.Time {
+	return e.start.Add(e.duration)
+}
+
+// FSM stores the state of leases in the system.
+type FSM struct {
+	mu         sync.Mutex
+	globalTime time.Time
+	entries    map[key]*entry
+}
+
+// NewFSM returns a new FSM with no leases, at the zero global time.
+func NewFSM() *FSM {
+	return &FSM{
+		entries: make(map[key]*entry),
+	}
+}
+
+// Apply applies the supplied command to the FSM. It returns
+// lease.ErrInvalid if the command is inconsistent with the current
+// lease state, and ErrTimeMismatch if a setTime command is stale.
+func (f *FSM) Apply(command Command) error {
+	if err := command.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch command.Operation {
+	case OperationClaim:
+		return f.claim(command)
+	case OperationExtend:
+		return f.extend(command)
+	case OperationExpire:
+		return f.expire(command)
+	case OperationSetTime:
+		return f.setTime(command)
+	}
+	// Validate rejects anything else.
+	return errors.NotValidf("operation %q", command.Operation)
+}
+
+func (f *FSM) claim(command Command) error {
+	k := key{command.Namespace, command.Lease}
+	if _, found := f.entries[k]; found {
+		return lease.ErrInvalid
+	}
+	f.entries[k] = &entry{
+		holder:   command.Holder,
+		start:    f.globalTime,
+		duration: command.Duration,
+	}
+	return nil
+}
+
+func (f *FSM) extend(command Command) error {
+	k := key{command.Namespace, command.Lease}
+	existing, found := f.entries[k]
+	if !found || existing.holder != command.Holder {
+		return lease.ErrInvalid
+	}
+	// Extending never shortens a lease.
+	if expiry := f.globalTime.Add(command.Duration); expiry.After(existing.expiry()) {
+		existing.start = f.globalTime
+		existing.duration = command.Duration
+	}
+	return nil
+}
+
+func (f *FSM) expire(command Command) error {
+	k := key{command.Namespace, command.Lease}
+	existing, found := f.entries[k]
+	if !found || existing.expiry().After(f.globalTime) {
+		return lease.ErrInvalid
+	}
+	delete(f.entries, k)
+	return nil
+}
+
+func (f *FSM) setTime(command Command) error {
+	if !command.OldTime.Equal(f.globalTime) {
+		return ErrTimeMismatch
+	}
+	f.globalTime = command.NewTime
+	return nil
+}
+
+// GlobalTime returns the FSM's current global time.
+func (f *FSM) GlobalTime() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.globalTime
+}
+
+// Leases returns a snapshot of the leases in the given namespace.
+// Expiry times are expressed in global time.
+func (f *FSM) Leases(namespace string) map[string]lease.Info {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	result := make(map[string]lease.Info)
+	for k, e := range f.entries {
+		if k.namespace != namespace {
+			continue
+		}
+		result[k.lease] = lease.Info{
+			Holder:   e.holder,
+			Expiry:   e.expiry(),
+			Trapdoor: lease.LockedTrapdoor,
+		}
+	}
+	return result
+}
@@ -0,0 +1,130 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package raftlease_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/lease"
+	"github.com/juju/juju/core/raftlease"
+)
+
+type fsmSuite struct {
+	testing.IsolationSuite
+	fsm *raftlease.FSM
+}
+
+var _ = gc.Suite(&fsmSuite{})
+
+func (s *fsmSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.fsm = raftlease.NewFSM()
+}
+
+func leaseCommand(operation, holder string, duration time.Duration) raftlease.Command {
+	return raftlease.Command{
+		Version:   raftlease.CommandVersion,
+		Operation: operation,
+		Namespace: "ns",
+		Lease:     "lease",
+		Holder:    holder,
+		Duration:  duration,
+	}
+}
+
+func (s *fsmSuite) advance(c *gc.C, d time.Duration) {
+	old := s.fsm.GlobalTime()
+	err := s.fsm.Apply(raftlease.Command{
+		Version:   raftlease.CommandVersion,
+		Operation: raftlease.OperationSetTime,
+		OldTime:   old,
+		NewTime:   old.Add(d),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *fsmSuite) TestClaim(c *gc.C) {
+	err := s.fsm.Apply(leaseCommand(raftlease.OperationClaim, "holder", time.Minute))
+	c.Assert(err, jc.ErrorIsNil)
+
+	leases := s.fsm.Leases("ns")
+	c.Assert(leases, gc.HasLen, 1)
+	c.Check(leases["lease"].Holder, gc.Equals, "holder")
+	c.Check(leases["lease"].Expiry, gc.Equals, time.Time{}.Add(time.Minute))
+	c.Check(leases["lease"].Trapdoor(nil), jc.ErrorIsNil)
+	c.Check(s.fsm.Leases("other"), gc.HasLen, 0)
+}
+
+func (s *fsmSuite) TestClaimHeld(c *gc.C) {
+	err := s.fsm.Apply(leaseCommand(raftlease.OperationClaim, "holder", time.Minute))
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.fsm.Apply(leaseCommand(raftlease.OperationClaim, "other", time.Minute))
+	c.Assert(err, gc.Equals, lease.ErrInvalid)
+}
+
+func (s *fsmSuite) TestExtend(c *gc.C) {
+	err := s.fsm.Apply(leaseCommand(raftlease.OperationClaim, "holder", time.Minute))
+	c.Assert(err, jc.ErrorIsNil)
+	s.advance(c, 30*time.Second)
+
+	err = s.fsm.Apply(leaseCommand(raftlease.OperationExtend, "holder", time.Minute))
+	c.Assert(err, jc.ErrorIsNil)
+	expiry := s.fsm.Leases("ns")["lease"].Expiry
+	c.Check(expiry, gc.Equals, time.Time{}.Add(90*time.Second))
+
+	// Extending for less time than remains leaves the expiry alone.
+	err = s.fsm.Apply(leaseCommand(raftlease.OperationExtend, "holder", time.Second))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.fsm.Leases("ns")["lease"].Expiry, gc.Equals, expiry)
+}
+
+func (s *fsmSuite) TestExtendNotHolder(c *gc.C) {
+	err := s.fsm.Apply(leaseCommand(raftlease.OperationExtend, "holder", time.Minute))
+	c.Assert(err, gc.Equals, lease.ErrInvalid)
+
+	err = s.fsm.Apply(leaseCommand(raftlease.OperationClaim, "holder", time.Minute))
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.fsm.Apply(leaseCommand(raftlease.OperationExtend, "other", time.Minute))
+	c.Assert(err, gc.Equals, lease.ErrInvalid)
+}
+
+func (s *fsmSuite) TestExpire(c *gc.C) {
+	err := s.fsm.Apply(leaseCommand(raftlease.OperationClaim, "holder", time.Minute))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Expiry is measured in global time only.
+	err = s.fsm.Apply(leaseCommand(raftlease.OperationExpire, "", 0))
+	c.Assert(err, gc.Equals, lease.ErrInvalid)
+
+	s.advance(c, time.Minute)
+	err = s.fsm.Apply(leaseCommand(raftlease.OperationExpire, "", 0))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.fsm.Leases("ns"), gc.HasLen, 0)
+
+	err = s.fsm.Apply(leaseCommand(raftlease.OperationExpire, "", 0))
+	c.Assert(err, gc.Equals, lease.ErrInvalid)
+}
+
+func (s *fsmSuite) TestSetTimeMismatch(c *gc.C) {
+	s.advance(c, time.Second)
+	err := s.fsm.Apply(raftlease.Command{
+		Version:   raftlease.CommandVersion,
+		Operation: raftlease.OperationSetTime,
+		OldTime:   time.Time{},
+		NewTime:   time.Time{}.Add(time.Minute),
+	})
+	c.Assert(err, gc.Equals, raftlease.ErrTimeMismatch)
+	c.Check(s.fsm.GlobalTime(), gc.Equals, time.Time{}.Add(time.Second))
+}
+
+func (s *fsmSuite) TestApplyInvalid(c *gc.C) {
+	err := s.fsm.Apply(leaseCommand("bump", "holder", time.Minute))
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	c.Check(s.fsm.Leases("ns"), gc.HasLen, 0)
+}
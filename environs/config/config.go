@@ -5,6 +5,8 @@ package config
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"strings"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/juju/osenv"
 	"github.com/juju/juju/logfwd/syslog"
+	"github.com/juju/juju/network"
 )
 
 var logger = loggo.GetLogger("juju.environs.config")
@@ -75,6 +78,9 @@ const (
 	// AgentMetadataURLKey stores the key for this setting.
 	AgentMetadataURLKey = "agent-metadata-url"
 
+	// ContainerImageMetadataURLKey stores the key for this setting.
+	ContainerImageMetadataURLKey = "container-image-metadata-url"
+
 	// HTTPProxyKey stores the key for this setting.
 	HTTPProxyKey = "http-proxy"
 
@@ -129,6 +135,22 @@ const (
 	// metrics collected in this model for anonymized aggregate analytics.
 	TransmitVendorMetricsKey = "transmit-vendor-metrics"
 
+	// FanConfigKey is the key for the fan overlays, given as
+	// <underlay-cidr>=<overlay-cidr> pairs, used for container addressing.
+	FanConfigKey = "fan-config"
+
+	// ContainerNetworkingMethodKey is the key for how containers get
+	// their network addresses: "local", "provider" or "fan".
+	ContainerNetworkingMethodKey = "container-networking-method"
+
+	// EgressSubnetsKey is the key for the comma separated CIDRs from
+	// which traffic leaving the model's machines is seen to originate.
+	EgressSubnetsKey = "egress-subnets"
+
+	// LoggingFormatKey is the key for the format agents write their
+	// log files in: "text" or "json".
+	LoggingFormatKey = "logging-format"
+
 	//
 	// Deprecated Settings Attributes
 	//
@@ -297,10 +319,11 @@ var defaultConfigValues = map[string]interface{}{
 	TransmitVendorMetricsKey:   true,
 
 	// Image and agent streams and URLs.
-	"image-stream":       "released",
-	"image-metadata-url": "",
-	AgentStreamKey:       "released",
-	AgentMetadataURLKey:  "",
+	"image-stream":               "released",
+	"image-metadata-url":         "",
+	AgentStreamKey:               "released",
+	AgentMetadataURLKey:          "",
+	ContainerImageMetadataURLKey: "",
 
 	// Log forward settings.
 	LogForwardEnabled: false,
@@ -446,6 +469,28 @@ func Validate(cfg, old *Config) error {
 		return errors.Errorf("uuid: expected UUID, got string(%q)", uuid)
 	}
 
+	if v, ok := cfg.ContainerImageMetadataURL(); ok {
+		if err := validateContainerImageMetadataURL(v); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	if err := validateContainerNetworking(cfg); err != nil {
+		return errors.Trace(err)
+	}
+
+	for _, cidr := range cfg.EgressSubnets() {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.NotValidf("%s %q", EgressSubnetsKey, cidr)
+		}
+	}
+
+	switch format := cfg.LoggingFormat(); format {
+	case "", "text", "json":
+	default:
+		return errors.NotValidf(`%s %q (expected "text" or "json")`, LoggingFormatKey, format)
+	}
+
 	// Ensure the resource tags have the expected k=v format.
 	if _, err := cfg.resourceTags(); err != nil {
 		return errors.Annotate(err, "validating resource tags")
@@ -473,6 +518,38 @@ func Validate(cfg, old *Config) error {
 	return nil
 }
 
+// validateContainerNetworking checks the fan-config and
+// container-networking-method settings, and that they agree.
+func validateContainerNetworking(cfg *Config) error {
+	fanConfig, err := cfg.FanConfig()
+	if err != nil {
+		return errors.Annotatef(err, "invalid %s", FanConfigKey)
+	}
+	switch method := cfg.ContainerNetworkingMethod(); method {
+	case "", "local", "provider":
+	case "fan":
+		if len(fanConfig) == 0 {
+			return errors.Errorf("%s %q requires %s to be set", ContainerNetworkingMethodKey, method, FanConfigKey)
+		}
+	default:
+		return errors.NotValidf(`%s %q (expected "local", "provider" or "fan")`, ContainerNetworkingMethodKey, method)
+	}
+	return nil
+}
+
+// validateContainerImageMetadataURL checks that the given container image
+// mirror URL is an absolute https URL, as required by LXD.
+func validateContainerImageMetadataURL(v string) error {
+	u, err := url.Parse(v)
+	if err != nil {
+		return errors.Annotatef(err, "invalid %s", ContainerImageMetadataURLKey)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.NotValidf("%s %q (expected an https URL)", ContainerImageMetadataURLKey, v)
+	}
+	return nil
+}
+
 func isEmpty(val interface{}) bool {
 	switch val := val.(type) {
 	case nil:
@@ -721,6 +798,49 @@ func (c *Config) ImageMetadataURL() (string, bool) {
 	return "", false
 }
 
+// ContainerImageMetadataURL returns the URL of the simplestreams mirror
+// from which container images are fetched, and whether it has been set.
+func (c *Config) ContainerImageMetadataURL() (string, bool) {
+	if url, ok := c.defined[ContainerImageMetadataURLKey]; ok && url != "" {
+		return url.(string), true
+	}
+	return "", false
+}
+
+// FanConfig returns the fan overlays configured for the model.
+func (c *Config) FanConfig() (network.FanConfig, error) {
+	return network.ParseFanConfig(c.asString(FanConfigKey))
+}
+
+// ContainerNetworkingMethod returns how containers get their network
+// addresses, or "" if the provider should decide.
+func (c *Config) ContainerNetworkingMethod() string {
+	return c.asString(ContainerNetworkingMethodKey)
+}
+
+// EgressSubnets returns the CIDRs from which traffic leaving the
+// model's machines is seen to originate, for example when the
+// machines sit behind NAT.
+func (c *Config) EgressSubnets() []string {
+	raw := c.asString(EgressSubnetsKey)
+	if raw == "" {
+		return nil
+	}
+	var cidrs []string
+	for _, cidr := range strings.Split(raw, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr != "" {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	return cidrs
+}
+
+// LoggingFormat returns the format agents write their log files in,
+// or "" for the default text format.
+func (c *Config) LoggingFormat() string {
+	return c.asString(LoggingFormatKey)
+}
+
 // Development returns whether the environment is in development mode.
 func (c *Config) Development() bool {
 	value, _ := c.defined["development"].(bool)
@@ -963,6 +1083,7 @@ var alwaysOptional = schema.Defaults{
 	"image-stream":               schema.Omit,
 	"image-metadata-url":         schema.Omit,
 	AgentMetadataURLKey:          schema.Omit,
+	ContainerImageMetadataURLKey: schema.Omit,
 	"default-series":             schema.Omit,
 	"development":                schema.Omit,
 	"ssl-hostname-verification":  schema.Omit,
@@ -972,6 +1093,10 @@ var alwaysOptional = schema.Defaults{
 	AutomaticallyRetryHooks:      schema.Omit,
 	"test-mode":                  schema.Omit,
 	TransmitVendorMetricsKey:     schema.Omit,
+	FanConfigKey:                 schema.Omit,
+	ContainerNetworkingMethodKey: schema.Omit,
+	EgressSubnetsKey:             schema.Omit,
+	LoggingFormatKey:             schema.Omit,
 }
 
 func allowEmpty(attr string) bool {
@@ -1115,6 +1240,27 @@ var configSchema = environschema.Fields{
 		Type:        environschema.Tstring,
 		Group:       environschema.EnvironGroup,
 	},
+	ContainerImageMetadataURLKey: {
+		Description: "The https URL of a simplestreams mirror from which container images are fetched",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	ContainerNetworkingMethodKey: {
+		Description: `How containers get their network addresses: 'local', 'provider' or 'fan'. Left empty, the provider decides.`,
+		Type:        environschema.Tstring,
+		Values:      []interface{}{"local", "provider", "fan"},
+		Group:       environschema.EnvironGroup,
+	},
+	EgressSubnetsKey: {
+		Description: "Comma separated CIDRs from which traffic leaving the model's machines originates, for use when they are behind NAT",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	FanConfigKey: {
+		Description: "Space separated <underlay-cidr>=<overlay-cidr> pairs configuring fan overlay networking for containers",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
 	AgentStreamKey: {
 		Description: `Version of Juju to use for deploy/upgrades.`,
 		Type:        environschema.Tstring,
@@ -1232,6 +1378,12 @@ global or per instance security groups.`,
 		Type:        environschema.Tstring,
 		Group:       environschema.EnvironGroup,
 	},
+	LoggingFormatKey: {
+		Description: `The format Juju agents write their log files in: 'text' or 'json'`,
+		Type:        environschema.Tstring,
+		Values:      []interface{}{"text", "json"},
+		Group:       environschema.EnvironGroup,
+	},
 	NameKey: {
 		Description: "The name of the current model",
 		Type:        environschema.Tstring,
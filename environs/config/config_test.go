@@ -103,6 +103,73 @@ var configTests = []configTest{
 			"image-metadata-url": "image-url",
 			"agent-metadata-url": "agent-metadata-url-value",
 		}),
+	}, {
+		about:       "Container image metadata URL",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"container-image-metadata-url": "https://images.example.com/releases",
+		}),
+	}, {
+		about:       "Invalid container image metadata URL scheme",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"container-image-metadata-url": "http://images.example.com/releases",
+		}),
+		err: `container-image-metadata-url "http://images.example.com/releases" \(expected an https URL\) not valid`,
+	}, {
+		about:       "Relative container image metadata URL",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"container-image-metadata-url": "images",
+		}),
+		err: `container-image-metadata-url "images" \(expected an https URL\) not valid`,
+	}, {
+		about:       "Fan container networking",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"container-networking-method": "fan",
+			"fan-config":                  "172.31.0.0/16=252.0.0.0/8",
+		}),
+	}, {
+		about:       "Fan container networking without fan-config",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"container-networking-method": "fan",
+		}),
+		err: `container-networking-method "fan" requires fan-config to be set`,
+	}, {
+		about:       "Invalid fan-config",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"fan-config": "172.31.0.0/16",
+		}),
+		err: `invalid fan-config: fan config entry "172.31.0.0/16" \(expected <underlay>=<overlay>\) not valid`,
+	}, {
+		about:       "Egress subnets",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"egress-subnets": "10.0.0.0/8, 192.168.1.0/24",
+		}),
+	}, {
+		about:       "Invalid egress subnet",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"egress-subnets": "10.0.0.0/8,10.1.2.3",
+		}),
+		err: `egress-subnets "10.1.2.3" not valid`,
+	}, {
+		about:       "JSON logging format",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"logging-format": "json",
+		}),
+	}, {
+		about:       "Invalid logging format",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"logging-format": "xml",
+		}),
+		err: `logging-format "xml" \(expected "text" or "json"\) not valid`,
 	}, {
 		about:       "Explicit series",
 		useDefaults: config.UseDefaults,
@@ -672,6 +739,36 @@ func (test configTest) check(c *gc.C, home *gitjujutesting.FakeHome) {
 		c.Assert(urlPresent, jc.IsFalse)
 	}
 
+	containerURL, urlPresent := cfg.ContainerImageMetadataURL()
+	if v, _ := test.attrs["container-image-metadata-url"].(string); v != "" {
+		c.Assert(containerURL, gc.Equals, v)
+		c.Assert(urlPresent, jc.IsTrue)
+	} else {
+		c.Assert(urlPresent, jc.IsFalse)
+	}
+
+	method, _ := test.attrs["container-networking-method"].(string)
+	c.Assert(cfg.ContainerNetworkingMethod(), gc.Equals, method)
+	fanConfig, err := cfg.FanConfig()
+	c.Assert(err, jc.ErrorIsNil)
+	if v, _ := test.attrs["fan-config"].(string); v != "" {
+		c.Assert(fanConfig.String(), gc.Equals, v)
+	} else {
+		c.Assert(fanConfig, gc.HasLen, 0)
+	}
+
+	if v, _ := test.attrs["egress-subnets"].(string); v != "" {
+		c.Assert(strings.Join(cfg.EgressSubnets(), ", "), gc.Equals, v)
+	} else {
+		c.Assert(cfg.EgressSubnets(), gc.HasLen, 0)
+	}
+
+	if v, _ := test.attrs["logging-format"].(string); v != "" {
+		c.Assert(cfg.LoggingFormat(), gc.Equals, v)
+	} else {
+		c.Assert(cfg.LoggingFormat(), gc.Equals, "")
+	}
+
 	agentURL, urlPresent := cfg.AgentMetadataURL()
 	expectedToolsURLValue := test.attrs["agent-metadata-url"]
 	if urlPresent {
@@ -0,0 +1,60 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package environs
+
+import (
+	"github.com/juju/version"
+)
+
+// Upgrader is an interface that an Environ may implement in order to
+// make changes to cloud resources when the controller is upgraded,
+// such as re-tagging resources or migrating security groups.
+type Upgrader interface {
+	// UpgradeOperations returns the operations required to upgrade
+	// the Environ's cloud resources, ordered by target version.
+	// Operations for versions at or below the version being upgraded
+	// from are not run.
+	UpgradeOperations(UpgradeOperationsParams) []UpgradeOperation
+}
+
+// UpgradeVersioner is an interface that an EnvironProvider whose
+// Environs implement Upgrader may implement, so that the controller can
+// tell whether an upgrade has environ upgrade operations to run without
+// opening the Environ of every model.
+type UpgradeVersioner interface {
+	// UpgradeTargetVersions returns the target versions of the
+	// operations returned by the Environs' UpgradeOperations.
+	UpgradeTargetVersions() []version.Number
+}
+
+// UpgradeOperationsParams contains the parameters for
+// Upgrader.UpgradeOperations.
+type UpgradeOperationsParams struct {
+	// ControllerUUID is the UUID of the controller that manages
+	// the Environ being upgraded.
+	ControllerUUID string
+}
+
+// UpgradeOperation contains a target agent version and the sequence
+// of upgrade steps to apply to get to that version.
+type UpgradeOperation struct {
+	// TargetVersion is the agent version to which the upgrade
+	// steps pertain.
+	TargetVersion version.Number
+
+	// Steps contains the upgrade steps to apply, in order, when
+	// upgrading to TargetVersion.
+	Steps []UpgradeStep
+}
+
+// UpgradeStep defines an idempotent operation that is run to perform
+// a specific upgrade step on an Environ's cloud resources.
+type UpgradeStep interface {
+	// Description is a human readable description of what the
+	// upgrade step does.
+	Description() string
+
+	// Run executes the upgrade step.
+	Run() error
+}
@@ -43,6 +43,18 @@ const (
 	// timestamps to be written in RFC3339 format.
 	JujuStatusIsoTimeEnvKey = "JUJU_STATUS_ISO_TIME"
 
+	// JujuLogFormatEnvKey is the env var which selects the format of
+	// the agents' log output: "text" (the default) or "json".
+	JujuLogFormatEnvKey = "JUJU_LOG_FORMAT"
+
+	// JujuLogEntityEnvKey is the env var holding the tag of the agent
+	// recorded in structured log output.
+	JujuLogEntityEnvKey = "JUJU_LOG_ENTITY"
+
+	// JujuLogLabelsEnvKey is the env var holding the comma separated
+	// key=value labels recorded in structured log output.
+	JujuLogLabelsEnvKey = "JUJU_LOG_LABELS"
+
 	// XDGDataHome is a path where data for the running user
 	// should be stored according to the xdg standard.
 	XDGDataHome = "XDG_DATA_HOME"
@@ -7,6 +7,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"net"
+	"strconv"
 
 	"github.com/juju/errors"
 	"github.com/juju/juju/cert"
@@ -54,9 +55,11 @@ func (cfg RawConfig) Validate() error {
 }
 
 func (cfg RawConfig) validateHost() error {
-	host, _, err := net.SplitHostPort(cfg.Host)
+	host, port, err := net.SplitHostPort(cfg.Host)
 	if err != nil {
 		host = cfg.Host
+	} else if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return errors.NotValidf("Host %q port", cfg.Host)
 	}
 	if host == "" && cfg.Enabled {
 		return errors.NotValidf("Host %q", cfg.Host)
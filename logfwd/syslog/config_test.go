@@ -91,6 +91,22 @@ func (s *ConfigSuite) TestRawValidateMissingHostname(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, `Host ":9876" not valid`)
 }
 
+func (s *ConfigSuite) TestRawValidateBadPort(c *gc.C) {
+	for i, host := range []string{"a.b.c:", "a.b.c:syslog", "a.b.c:0", "a.b.c:65536"} {
+		c.Logf("test %d: %s", i, host)
+		cfg := syslog.RawConfig{
+			Host:       host,
+			CACert:     coretesting.CACert,
+			ClientCert: coretesting.ServerCert,
+			ClientKey:  coretesting.ServerKey,
+		}
+
+		err := cfg.Validate()
+
+		c.Check(err, gc.ErrorMatches, `Host ".*" port not valid`)
+	}
+}
+
 func (s *ConfigSuite) TestRawValidateMissingCACert(c *gc.C) {
 	cfg := syslog.RawConfig{
 		Host:       "a.b.c:9876",
@@ -18,11 +18,14 @@ import (
 // Private network ranges for IPv4 and IPv6.
 // See: http://tools.ietf.org/html/rfc1918
 // Also: http://tools.ietf.org/html/rfc4193
+// And the deprecated, but still deployed, site-local range from
+// http://tools.ietf.org/html/rfc3879
 var (
 	classAPrivate   = mustParseCIDR("10.0.0.0/8")
 	classBPrivate   = mustParseCIDR("172.16.0.0/12")
 	classCPrivate   = mustParseCIDR("192.168.0.0/16")
 	ipv6UniqueLocal = mustParseCIDR("fc00::/7")
+	ipv6SiteLocal   = mustParseCIDR("fec0::/10")
 )
 
 const (
@@ -218,7 +221,7 @@ func isIPv6UniqueLocalAddress(addrType AddressType, ip net.IP) bool {
 	if addrType != IPv6Address {
 		return false
 	}
-	return ipv6UniqueLocal.Contains(ip)
+	return ipv6UniqueLocal.Contains(ip) || ipv6SiteLocal.Contains(ip)
 }
 
 // deriveScope attempts to derive the network scope from an address's
@@ -84,6 +84,8 @@ func (s *AddressSuite) TestNewScopedAddressIPv6(c *gc.C) {
 		{"fc00::1", network.ScopeCloudLocal},
 		// unique local address (ULA) - second group
 		{"fd00::1", network.ScopeCloudLocal},
+		// deprecated site-local address
+		{"fec0::1", network.ScopeCloudLocal},
 		// IPv4-mapped IPv6 address
 		{"::ffff:0:0:1", network.ScopePublic},
 		// IPv4-translated IPv6 address (SIIT)
@@ -0,0 +1,92 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// FanConfigEntry defines a single fan overlay: hosts with addresses
+// in Underlay give their containers addresses carved from Overlay.
+type FanConfigEntry struct {
+	Underlay *net.IPNet
+	Overlay  *net.IPNet
+}
+
+// FanConfig holds the fan overlays configured for a model.
+type FanConfig []*FanConfigEntry
+
+// String returns the fan configuration in the form accepted by
+// ParseFanConfig.
+func (fc FanConfig) String() string {
+	entries := make([]string, len(fc))
+	for i, entry := range fc {
+		entries[i] = fmt.Sprintf("%s=%s", entry.Underlay, entry.Overlay)
+	}
+	return strings.Join(entries, " ")
+}
+
+// ParseFanConfig parses a space separated list of
+// <underlay-cidr>=<overlay-cidr> pairs, e.g.
+// "172.31.0.0/16=252.0.0.0/8 10.0.0.0/16=253.0.0.0/8".
+func ParseFanConfig(line string) (FanConfig, error) {
+	var config FanConfig
+	for _, part := range strings.Fields(line) {
+		cidrs := strings.Split(part, "=")
+		if len(cidrs) != 2 {
+			return nil, errors.NotValidf("fan config entry %q (expected <underlay>=<overlay>)", part)
+		}
+		_, underlay, err := net.ParseCIDR(cidrs[0])
+		if err != nil {
+			return nil, errors.Annotatef(err, "invalid fan underlay %q", cidrs[0])
+		}
+		_, overlay, err := net.ParseCIDR(cidrs[1])
+		if err != nil {
+			return nil, errors.Annotatef(err, "invalid fan overlay %q", cidrs[1])
+		}
+		if underlay.IP.To4() == nil || overlay.IP.To4() == nil {
+			return nil, errors.NotValidf("fan config entry %q (only IPv4 is supported)", part)
+		}
+		underlaySize, _ := underlay.Mask.Size()
+		overlaySize, _ := overlay.Mask.Size()
+		if overlaySize >= underlaySize {
+			return nil, errors.NotValidf("fan config entry %q (overlay must be larger than underlay)", part)
+		}
+		config = append(config, &FanConfigEntry{
+			Underlay: underlay,
+			Overlay:  overlay,
+		})
+	}
+	return config, nil
+}
+
+// BridgeName returns the name of the bridge that the fan creates on a
+// host for the entry's overlay, following the fan's convention of
+// naming it after the significant octets of the overlay, e.g. "fan-252"
+// for 252.0.0.0/8.
+func (e *FanConfigEntry) BridgeName() string {
+	size, _ := e.Overlay.Mask.Size()
+	ip := e.Overlay.IP.To4()
+	octets := []string{"fan"}
+	for i := 0; i < (size+7)/8; i++ {
+		octets = append(octets, fmt.Sprint(ip[i]))
+	}
+	return strings.Join(octets, "-")
+}
+
+// EntryForAddress returns the entry whose underlay contains the given
+// host address, or an error satisfying errors.IsNotFound if there is
+// none.
+func (fc FanConfig) EntryForAddress(ip net.IP) (*FanConfigEntry, error) {
+	for _, entry := range fc {
+		if entry.Underlay.Contains(ip) {
+			return entry, nil
+		}
+	}
+	return nil, errors.NotFoundf("fan underlay for address %v", ip)
+}
@@ -0,0 +1,87 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network_test
+
+import (
+	"net"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/network"
+	"github.com/juju/juju/testing"
+)
+
+type FanConfigSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&FanConfigSuite{})
+
+func (*FanConfigSuite) TestParseFanConfig(c *gc.C) {
+	config, err := network.ParseFanConfig("172.31.0.0/16=252.0.0.0/8  10.0.0.0/16=253.0.0.0/8")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(config, gc.HasLen, 2)
+	c.Check(config[0].Underlay.String(), gc.Equals, "172.31.0.0/16")
+	c.Check(config[0].Overlay.String(), gc.Equals, "252.0.0.0/8")
+	c.Check(config[1].Underlay.String(), gc.Equals, "10.0.0.0/16")
+	c.Check(config[1].Overlay.String(), gc.Equals, "253.0.0.0/8")
+	c.Check(config.String(), gc.Equals, "172.31.0.0/16=252.0.0.0/8 10.0.0.0/16=253.0.0.0/8")
+}
+
+func (*FanConfigSuite) TestParseFanConfigEmpty(c *gc.C) {
+	config, err := network.ParseFanConfig("")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(config, gc.HasLen, 0)
+}
+
+func (*FanConfigSuite) TestParseFanConfigErrors(c *gc.C) {
+	for i, test := range []struct {
+		line string
+		err  string
+	}{{
+		line: "172.31.0.0/16",
+		err:  `fan config entry "172.31.0.0/16" \(expected <underlay>=<overlay>\) not valid`,
+	}, {
+		line: "172.31.0.0/16=252.0.0.0/8=1.0.0.0/8",
+		err:  `fan config entry .* \(expected <underlay>=<overlay>\) not valid`,
+	}, {
+		line: "foo=252.0.0.0/8",
+		err:  `invalid fan underlay "foo": .*`,
+	}, {
+		line: "172.31.0.0/16=bar",
+		err:  `invalid fan overlay "bar": .*`,
+	}, {
+		line: "fc00::/64=fd00::/32",
+		err:  `fan config entry .* \(only IPv4 is supported\) not valid`,
+	}, {
+		line: "172.31.0.0/16=252.0.0.0/24",
+		err:  `fan config entry .* \(overlay must be larger than underlay\) not valid`,
+	}} {
+		c.Logf("test %d: %q", i, test.line)
+		_, err := network.ParseFanConfig(test.line)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (*FanConfigSuite) TestBridgeName(c *gc.C) {
+	config, err := network.ParseFanConfig("172.31.0.0/16=252.0.0.0/8 10.0.0.0/24=253.1.0.0/16")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(config[0].BridgeName(), gc.Equals, "fan-252")
+	c.Check(config[1].BridgeName(), gc.Equals, "fan-253-1")
+}
+
+func (*FanConfigSuite) TestEntryForAddress(c *gc.C) {
+	config, err := network.ParseFanConfig("172.31.0.0/16=252.0.0.0/8 10.0.0.0/16=253.0.0.0/8")
+	c.Assert(err, jc.ErrorIsNil)
+
+	entry, err := config.EntryForAddress(net.ParseIP("10.0.3.4"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(entry, gc.Equals, config[1])
+
+	_, err = config.EntryForAddress(net.ParseIP("192.168.1.1"))
+	c.Check(err, jc.Satisfies, errors.IsNotFound)
+	c.Check(err, gc.ErrorMatches, "fan underlay for address 192.168.1.1 not found")
+}
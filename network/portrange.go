@@ -43,8 +43,9 @@ func (p PortRange) Validate() error {
 }
 
 // ConflictsWith determines if the two port ranges conflict.
+// Protocols are compared case-insensitively, as in Validate.
 func (a PortRange) ConflictsWith(b PortRange) bool {
-	if a.Protocol != b.Protocol {
+	if !strings.EqualFold(a.Protocol, b.Protocol) {
 		return false
 	}
 	return a.ToPort >= b.FromPort && b.ToPort >= a.FromPort
@@ -48,6 +48,11 @@ func (*PortRangeSuite) TestConflictsWith(c *gc.C) {
 		network.PortRange{80, 80, "UDP"},
 		network.PortRange{80, 80, "TCP"},
 		false,
+	}, {
+		"protocols differing only in case",
+		network.PortRange{80, 80, "tcp"},
+		network.PortRange{80, 80, "TCP"},
+		true,
 	}, {
 		"outside range",
 		network.PortRange{100, 200, "TCP"},
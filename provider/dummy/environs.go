@@ -299,6 +299,7 @@ func Reset(c *gc.C) {
 	dummy.supportsSpaces = true
 	dummy.supportsSpaceDiscovery = false
 	dummy.mu.Unlock()
+	resetInjections()
 
 	// NOTE(axw) we must destroy the old states without holding
 	// the provider lock, or we risk deadlocking. Destroying
@@ -638,7 +639,7 @@ func (e *environ) checkBroken(method string) error {
 			return fmt.Errorf("dummy.%s is broken", method)
 		}
 	}
-	return nil
+	return checkInjected(e.name, method)
 }
 
 // PrecheckInstance is specified in the state.Prechecker interface.
@@ -819,6 +820,8 @@ func (e *environ) Bootstrap(ctx environs.BootstrapContext, args environs.Bootstr
 			}
 			estate.apiState = st
 		}
+		estate.mu.Lock()
+		defer estate.mu.Unlock()
 		estate.ops <- OpFinalizeBootstrap{Context: ctx, Env: e.name, InstanceConfig: icfg}
 		return nil
 	}
@@ -841,11 +844,13 @@ func (e *environ) ControllerInstances(controllerUUID string) ([]instance.Id, err
 	if err != nil {
 		return nil, err
 	}
-	estate.mu.Lock()
-	defer estate.mu.Unlock()
+	// Check before taking the state mutex, as injected failures
+	// are reported under the dummy mutex, which Listen takes first.
 	if err := e.checkBroken("ControllerInstances"); err != nil {
 		return nil, err
 	}
+	estate.mu.Lock()
+	defer estate.mu.Unlock()
 	if !estate.bootstrapped {
 		return nil, environs.ErrNotBootstrapped
 	}
@@ -889,18 +894,17 @@ func (e *environ) Destroy() (res error) {
 		// The estate is a pointer to a structure that is stored in the dummy global.
 		// The Listen method can change the ops channel of any state, and will do so
 		// under the covers. What we need to do is use the state mutex to add a memory
-		// barrier such that the ops channel we see here is the latest.
+		// barrier such that the ops channel we see here is the latest, and hold it
+		// while sending so that nothing is sent to a channel Listen has replaced.
 		estate.mu.Lock()
-		ops := estate.ops
-		name := estate.name
-		delete(dummy.state, e.modelUUID)
-		estate.mu.Unlock()
-		ops <- OpDestroy{
-			Env:         name,
+		defer estate.mu.Unlock()
+		estate.ops <- OpDestroy{
+			Env:         estate.name,
 			Cloud:       e.cloud.Name,
 			CloudRegion: e.cloud.Region,
 			Error:       res,
 		}
+		delete(dummy.state, e.modelUUID)
 	}()
 	if err := e.checkBroken("Destroy"); err != nil {
 		return err
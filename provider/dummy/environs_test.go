@@ -334,6 +334,88 @@ func (s *suite) TestSubnets(c *gc.C) {
 	c.Assert(netInfo, gc.HasLen, 0)
 }
 
+func (s *suite) TestInjectFailures(c *gc.C) {
+	e := s.bootstrapTestEnviron(c)
+	defer func() {
+		err := e.Destroy()
+		c.Assert(err, jc.ErrorIsNil)
+	}()
+
+	journal := dummy.RecordOperations()
+	dummy.InjectFailures("AllInstances", 2, errors.New("no capacity"))
+	for i := 0; i < 2; i++ {
+		_, err := e.AllInstances()
+		c.Assert(err, gc.ErrorMatches, "no capacity")
+	}
+	_, err := e.AllInstances()
+	c.Assert(err, jc.ErrorIsNil)
+
+	ops := journal.Close()
+	c.Assert(ops, gc.HasLen, 2)
+	for _, op := range ops {
+		c.Assert(op, jc.DeepEquals, dummy.OpInjectedFailure{
+			Env:    e.Config().Name(),
+			Method: "AllInstances",
+			Err:    errors.New("no capacity"),
+		})
+	}
+}
+
+func (s *suite) TestInjectFailuresDefaultError(c *gc.C) {
+	e := s.bootstrapTestEnviron(c)
+	defer func() {
+		err := e.Destroy()
+		c.Assert(err, jc.ErrorIsNil)
+	}()
+
+	restore := dummy.InjectFailures("AllInstances", 5, nil)
+	_, err := e.AllInstances()
+	c.Assert(err, gc.ErrorMatches, `dummy\.AllInstances failed \(injected\)`)
+
+	restore()
+	_, err = e.AllInstances()
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *suite) TestInjectDelay(c *gc.C) {
+	e := s.bootstrapTestEnviron(c)
+	defer func() {
+		err := e.Destroy()
+		c.Assert(err, jc.ErrorIsNil)
+	}()
+
+	restore := dummy.InjectDelay("AllInstances", 50*time.Millisecond)
+	defer restore()
+	start := time.Now()
+	_, err := e.AllInstances()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(time.Since(start) >= 50*time.Millisecond, jc.IsTrue)
+}
+
+func (s *suite) TestRecordOperations(c *gc.C) {
+	e := s.bootstrapTestEnviron(c)
+	defer func() {
+		err := e.Destroy()
+		c.Assert(err, jc.ErrorIsNil)
+	}()
+
+	journal := dummy.RecordOperations()
+	_, err := e.NetworkInterfaces("i-42")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = e.Subnets("i-42", nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	ops := journal.Close()
+	c.Assert(ops, gc.HasLen, 2)
+	c.Assert(ops[0], gc.FitsTypeOf, dummy.OpNetworkInterfaces{})
+	c.Assert(ops[1], gc.FitsTypeOf, dummy.OpSubnets{})
+
+	// Operations after Close are not recorded.
+	_, err = e.Subnets("i-42", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(journal.Operations(), gc.HasLen, 2)
+}
+
 func assertInterfaces(c *gc.C, e environs.Environ, opc chan dummy.Operation, expectInstId instance.Id, expectInfo []network.InterfaceInfo) {
 	select {
 	case op := <-opc:
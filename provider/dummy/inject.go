@@ -0,0 +1,115 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dummy
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// OpInjectedFailure is sent on the operations channel whenever an
+// Environ method fails because of a failure injected with InjectFailures.
+type OpInjectedFailure struct {
+	Env    string
+	Method string
+	Err    error
+}
+
+// injectedFailure records how many more times a method should fail,
+// and with what error.
+type injectedFailure struct {
+	remaining int
+	err       error
+}
+
+// injector holds the failures and delays injected into dummy Environ
+// methods by tests. It is shared by all dummy environs, and cleared by
+// Reset.
+var injector = struct {
+	mu       sync.Mutex
+	failures map[string]*injectedFailure
+	delays   map[string]time.Duration
+}{
+	failures: make(map[string]*injectedFailure),
+	delays:   make(map[string]time.Duration),
+}
+
+// InjectFailures causes the next n calls to the named Environ method
+// (e.g. "StartInstance") on any dummy environ to fail with err. If err
+// is nil, a generic error naming the method is used instead. Subsequent
+// calls behave normally. It returns a function that removes any failures
+// that have not yet been consumed.
+func InjectFailures(method string, n int, err error) func() {
+	if err == nil {
+		err = fmt.Errorf("dummy.%s failed (injected)", method)
+	}
+	injector.mu.Lock()
+	defer injector.mu.Unlock()
+	failure := &injectedFailure{remaining: n, err: err}
+	injector.failures[method] = failure
+	return func() {
+		injector.mu.Lock()
+		defer injector.mu.Unlock()
+		if injector.failures[method] == failure {
+			delete(injector.failures, method)
+		}
+	}
+}
+
+// InjectDelay causes every call to the named Environ method on any
+// dummy environ to pause for d before doing anything else, simulating
+// a slow provider. It returns a function that removes the delay.
+func InjectDelay(method string, d time.Duration) func() {
+	injector.mu.Lock()
+	defer injector.mu.Unlock()
+	injector.delays[method] = d
+	return func() {
+		injector.mu.Lock()
+		defer injector.mu.Unlock()
+		if injector.delays[method] == d {
+			delete(injector.delays, method)
+		}
+	}
+}
+
+// resetInjections removes all injected failures and delays.
+func resetInjections() {
+	injector.mu.Lock()
+	defer injector.mu.Unlock()
+	injector.failures = make(map[string]*injectedFailure)
+	injector.delays = make(map[string]time.Duration)
+}
+
+// checkInjected applies any delay injected for the named method, and
+// returns the injected error if the method should fail. Each injected
+// failure is reported on the operations channel.
+func checkInjected(envName, method string) error {
+	injector.mu.Lock()
+	d := injector.delays[method]
+	var err error
+	if failure := injector.failures[method]; failure != nil {
+		err = failure.err
+		failure.remaining--
+		if failure.remaining <= 0 {
+			delete(injector.failures, method)
+		}
+	}
+	injector.mu.Unlock()
+
+	if d > 0 {
+		logger.Infof("injected delay of %v for dummy.%s", d, method)
+		<-time.After(d)
+	}
+	if err == nil {
+		return nil
+	}
+	logger.Infof("injected failure for dummy.%s: %v", method, err)
+	// Send while holding the mutex, so that once Listen returns
+	// nothing is sent to the old channel.
+	dummy.mu.Lock()
+	defer dummy.mu.Unlock()
+	dummy.ops <- OpInjectedFailure{Env: envName, Method: method, Err: err}
+	return err
+}
@@ -0,0 +1,71 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package dummy
+
+import (
+	"sync"
+)
+
+// Journal records, in order, every operation performed on any dummy
+// environ while it is listening. It allows tests to make assertions
+// about the whole sequence of operations once the code under test has
+// finished, rather than consuming operations as they happen.
+type Journal struct {
+	ops  chan Operation
+	stop chan struct{}
+	done chan struct{}
+
+	mu       sync.Mutex
+	recorded []Operation
+}
+
+// RecordOperations starts recording operations into a new Journal, in
+// place of any channel previously passed to Listen. Call Close to stop
+// recording.
+func RecordOperations() *Journal {
+	j := &Journal{
+		ops:  make(chan Operation),
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go j.loop()
+	Listen(j.ops)
+	return j
+}
+
+// loop records operations until the journal is closed.
+func (j *Journal) loop() {
+	defer close(j.done)
+	for {
+		select {
+		case <-j.stop:
+			return
+		case op := <-j.ops:
+			j.mu.Lock()
+			j.recorded = append(j.recorded, op)
+			j.mu.Unlock()
+		}
+	}
+}
+
+// Operations returns the operations recorded so far.
+func (j *Journal) Operations() []Operation {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	ops := make([]Operation, len(j.recorded))
+	copy(ops, j.recorded)
+	return ops
+}
+
+// Close stops recording, discarding any subsequent operations, and
+// returns the complete list of recorded operations.
+func (j *Journal) Close() []Operation {
+	// Operations are sent while holding the locks Listen takes, so
+	// once it returns every operation sent to the journal has been
+	// received by the loop, and no more will be.
+	Listen(nil)
+	close(j.stop)
+	<-j.done
+	return j.Operations()
+}
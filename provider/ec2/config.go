@@ -5,6 +5,8 @@ package ec2
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 
 	"github.com/juju/schema"
 	"gopkg.in/juju/environschema.v1"
@@ -26,6 +28,35 @@ var configSchema = environschema.Fields{
 		Group:       environschema.AccountGroup,
 		Immutable:   true,
 	},
+	"iam-instance-profile": {
+		Description: "The name or ARN of an IAM instance profile to associate with new instances (optional). Allows workloads to use AWS role-based credentials.",
+		Example:     "juju-workload-profile",
+		Type:        environschema.Tstring,
+		Group:       environschema.AccountGroup,
+	},
+	"instance-metadata-http-tokens": {
+		Description: "Whether instances require session tokens (IMDSv2) to access the instance metadata service: \"required\" or \"optional\".",
+		Type:        environschema.Tstring,
+		Group:       environschema.AccountGroup,
+		Values:      []interface{}{metadataTokensRequired, metadataTokensOptional},
+	},
+	"instance-metadata-hop-limit": {
+		Description: "The maximum number of network hops a response from the instance metadata service may travel (1-64). Containers need at least 2.",
+		Type:        environschema.Tint,
+		Group:       environschema.AccountGroup,
+	},
+	"instance-market": {
+		Description: "The market to start instances in: \"on-demand\" or \"spot\". Spot instances fall back to on-demand when spot capacity is unavailable.",
+		Type:        environschema.Tstring,
+		Group:       environschema.AccountGroup,
+		Values:      []interface{}{instanceMarketOnDemand, instanceMarketSpot},
+	},
+	"spot-max-price": {
+		Description: "The maximum hourly price, in USD, to pay for spot instances (optional). Defaults to the on-demand price.",
+		Example:     "0.05",
+		Type:        environschema.Tstring,
+		Group:       environschema.AccountGroup,
+	},
 }
 
 var configFields = func() schema.Fields {
@@ -37,8 +68,15 @@ var configFields = func() schema.Fields {
 }()
 
 var configDefaults = schema.Defaults{
-	"vpc-id":       "",
-	"vpc-id-force": false,
+	"vpc-id":               "",
+	"vpc-id-force":         false,
+	"iam-instance-profile": "",
+
+	"instance-metadata-http-tokens": metadataTokensRequired,
+	"instance-metadata-hop-limit":   2,
+
+	"instance-market": instanceMarketOnDemand,
+	"spot-max-price":  "",
 }
 
 type environConfig struct {
@@ -54,6 +92,31 @@ func (c *environConfig) forceVPCID() bool {
 	return c.attrs["vpc-id-force"].(bool)
 }
 
+func (c *environConfig) iamInstanceProfile() string {
+	return c.attrs["iam-instance-profile"].(string)
+}
+
+func (c *environConfig) instanceMetadataOptions() instanceMetadataOptions {
+	return instanceMetadataOptions{
+		HTTPTokens: c.attrs["instance-metadata-http-tokens"].(string),
+		HopLimit:   c.attrs["instance-metadata-hop-limit"].(int),
+	}
+}
+
+func (c *environConfig) instanceMarket() string {
+	return c.attrs["instance-market"].(string)
+}
+
+func (c *environConfig) spotMaxPrice() string {
+	return c.attrs["spot-max-price"].(string)
+}
+
+// validIAMInstanceProfile matches either an IAM instance profile name
+// or a full instance profile ARN.
+var validIAMInstanceProfile = regexp.MustCompile(
+	`^([\w+=,.@-]{1,128}|arn:aws[\w-]*:iam::\d{12}:instance-profile/[\w+=,.@/-]{1,128})$`,
+)
+
 func (p environProvider) newConfig(cfg *config.Config) (*environConfig, error) {
 	valid, err := p.Validate(cfg, nil)
 	if err != nil {
@@ -100,6 +163,20 @@ func validateConfig(cfg, old *config.Config) (*environConfig, error) {
 		return nil, fmt.Errorf("cannot use vpc-id-force without specifying vpc-id as well")
 	}
 
+	if profile := ecfg.iamInstanceProfile(); profile != "" && !validIAMInstanceProfile.MatchString(profile) {
+		return nil, fmt.Errorf("iam-instance-profile: %q is not a valid instance profile name or ARN", profile)
+	}
+
+	if hopLimit := ecfg.instanceMetadataOptions().HopLimit; hopLimit < 1 || hopLimit > 64 {
+		return nil, fmt.Errorf("instance-metadata-hop-limit: %d is out of range, expected 1-64", hopLimit)
+	}
+
+	if maxPrice := ecfg.spotMaxPrice(); maxPrice != "" {
+		if price, err := strconv.ParseFloat(maxPrice, 64); err != nil || price <= 0 {
+			return nil, fmt.Errorf("spot-max-price: %q is not a valid price", maxPrice)
+		}
+	}
+
 	if old != nil {
 		attrs := old.UnknownAttrs()
 
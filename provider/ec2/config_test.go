@@ -274,6 +274,92 @@ var configTests = []configTest{
 			"ssl-hostname-verification": false,
 		},
 		err: ".*disabling ssh-hostname-verification is not supported",
+	}, {
+		config: attrs{},
+		expect: attrs{
+			"iam-instance-profile": "",
+		},
+	}, {
+		config: attrs{
+			"iam-instance-profile": "juju-workload",
+		},
+		expect: attrs{
+			"iam-instance-profile": "juju-workload",
+		},
+	}, {
+		config: attrs{
+			"iam-instance-profile": "arn:aws:iam::123456789012:instance-profile/path/juju-workload",
+		},
+		expect: attrs{
+			"iam-instance-profile": "arn:aws:iam::123456789012:instance-profile/path/juju-workload",
+		},
+	}, {
+		config: attrs{
+			"iam-instance-profile": "not a profile",
+		},
+		err: `.*iam-instance-profile: "not a profile" is not a valid instance profile name or ARN`,
+	}, {
+		change: attrs{
+			"iam-instance-profile": "juju-workload",
+		},
+		expect: attrs{
+			"iam-instance-profile": "juju-workload",
+		},
+	}, {
+		config: attrs{},
+		expect: attrs{
+			"instance-metadata-http-tokens": "required",
+			"instance-metadata-hop-limit":   2,
+		},
+	}, {
+		config: attrs{
+			"instance-metadata-http-tokens": "optional",
+			"instance-metadata-hop-limit":   1,
+		},
+		expect: attrs{
+			"instance-metadata-http-tokens": "optional",
+			"instance-metadata-hop-limit":   1,
+		},
+	}, {
+		config: attrs{
+			"instance-metadata-http-tokens": "sometimes",
+		},
+		err: `.*instance-metadata-http-tokens: expected one of .*, got "sometimes"`,
+	}, {
+		config: attrs{
+			"instance-metadata-hop-limit": 65,
+		},
+		err: `.*instance-metadata-hop-limit: 65 is out of range, expected 1-64`,
+	}, {
+		config: attrs{},
+		expect: attrs{
+			"instance-market": "on-demand",
+			"spot-max-price":  "",
+		},
+	}, {
+		config: attrs{
+			"instance-market": "spot",
+			"spot-max-price":  "0.125",
+		},
+		expect: attrs{
+			"instance-market": "spot",
+			"spot-max-price":  "0.125",
+		},
+	}, {
+		config: attrs{
+			"instance-market": "reserved",
+		},
+		err: `.*instance-market: expected one of .*, got "reserved"`,
+	}, {
+		config: attrs{
+			"spot-max-price": "cheap",
+		},
+		err: `.*spot-max-price: "cheap" is not a valid price`,
+	}, {
+		config: attrs{
+			"spot-max-price": "-1",
+		},
+		err: `.*spot-max-price: "-1" is not a valid price`,
 	}, {
 		config: attrs{
 			"future": "hammerstein",
@@ -418,6 +418,47 @@ func (s *ebsSuite) TestDescribeVolumesNotFound(c *gc.C) {
 	c.Assert(vols[0].Error, gc.ErrorMatches, "vol-42 not found")
 }
 
+func (s *ebsSuite) TestImportVolume(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	c.Assert(vs, gc.Implements, new(storage.VolumeImporter))
+	info, err := vs.(storage.VolumeImporter).ImportVolume("vol-1", map[string]string{
+		"juju-model-uuid": "new-model",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info, jc.DeepEquals, storage.VolumeInfo{
+		Size:       20480,
+		VolumeId:   "vol-1",
+		Persistent: true,
+	})
+
+	ec2Client := ec2.StorageEC2(vs)
+	ec2Vols, err := ec2Client.Volumes([]string{"vol-1"}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ec2Vols.Volumes, gc.HasLen, 1)
+	c.Assert(ec2Vols.Volumes[0].Tags, jc.SameContents, []awsec2.Tag{
+		{"juju-model-uuid", "new-model"},
+		{"Name", "juju-sample-volume-1"},
+	})
+}
+
+func (s *ebsSuite) TestImportVolumeInUse(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	params := s.setupAttachVolumesTest(c, vs, ec2test.Running)
+	_, err := vs.AttachVolumes(params)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = vs.(storage.VolumeImporter).ImportVolume("vol-0", nil)
+	c.Assert(err, gc.ErrorMatches, `cannot import volume with status "in-use"`)
+}
+
+func (s *ebsSuite) TestImportVolumeNotFound(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	_, err := vs.(storage.VolumeImporter).ImportVolume("vol-42", nil)
+	c.Assert(err, gc.ErrorMatches, ".*vol-42.*")
+}
+
 func (s *ebsSuite) TestListVolumes(c *gc.C) {
 	vs := s.volumeSource(c, nil)
 	s.assertCreateVolumes(c, vs, "")
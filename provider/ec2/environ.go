@@ -475,6 +475,7 @@ func (e *environ) StartInstance(args environs.StartInstanceParams) (_ *environs.
 	}
 
 	var instResp *ec2.RunInstancesResp
+	var market string
 	commonRunArgs := &ec2.RunInstances{
 		MinCount:            1,
 		MaxCount:            1,
@@ -483,6 +484,7 @@ func (e *environ) StartInstance(args environs.StartInstanceParams) (_ *environs.
 		SecurityGroups:      groups,
 		BlockDeviceMappings: blockDeviceMappings,
 		ImageId:             spec.Image.Id,
+		IAMInstanceProfile:  e.ecfg().iamInstanceProfile(),
 	}
 
 	haveVPCID := isVPCIDSet(e.ecfg().vpcID())
@@ -525,7 +527,7 @@ func (e *environ) StartInstance(args environs.StartInstanceParams) (_ *environs.
 			logger.Infof("selected subnet %q in zone %q", runArgs.SubnetId, zone)
 		}
 
-		instResp, err = runInstances(e.ec2, runArgs)
+		instResp, market, err = e.runInstancesInMarket(runArgs)
 		if err == nil || !isZoneOrSubnetConstrainedError(err) {
 			break
 		}
@@ -548,9 +550,9 @@ func (e *environ) StartInstance(args environs.StartInstanceParams) (_ *environs.
 	if haveVPCID {
 		instVPC := e.ecfg().vpcID()
 		instSubnet := inst.Instance.SubnetId
-		logger.Infof("started instance %q in AZ %q, subnet %q, VPC %q", inst.Id(), instAZ, instSubnet, instVPC)
+		logger.Infof("started %s instance %q in AZ %q, subnet %q, VPC %q", market, inst.Id(), instAZ, instSubnet, instVPC)
 	} else {
-		logger.Infof("started instance %q in AZ %q", inst.Id(), instAZ)
+		logger.Infof("started %s instance %q in AZ %q", market, inst.Id(), instAZ)
 	}
 
 	// Tag instance, for accounting and identification.
@@ -558,6 +560,7 @@ func (e *environ) StartInstance(args environs.StartInstanceParams) (_ *environs.
 		names.NewMachineTag(args.InstanceConfig.MachineId), e.Config().Name(),
 	)
 	args.InstanceConfig.Tags[tagName] = instanceName
+	args.InstanceConfig.Tags[tagInstanceMarket] = market
 	if err := tagResources(e.ec2, args.InstanceConfig.Tags, string(inst.Id())); err != nil {
 		return nil, errors.Annotate(err, "tagging instance")
 	}
@@ -1270,10 +1273,46 @@ func (e *environ) controllerSecurityGroups(controllerUUID string) ([]ec2.Securit
 	return groups, nil
 }
 
+// modelSecurityGroups returns the details of all security groups tagged
+// with the environment's model UUID.
+func (e *environ) modelSecurityGroups() ([]ec2.SecurityGroup, error) {
+	filter := ec2.NewFilter()
+	e.addModelFilter(filter)
+	resp, err := e.ec2.SecurityGroups(nil, filter)
+	if err != nil {
+		return nil, errors.Annotate(err, "listing security groups")
+	}
+	groups := make([]ec2.SecurityGroup, len(resp.Groups))
+	for i, info := range resp.Groups {
+		groups[i] = ec2.SecurityGroup{Id: info.Id, Name: info.Name}
+	}
+	return groups, nil
+}
+
 // cleanEnvironmentSecurityGroups attempts to delete all security groups owned
-// by the environment.
+// by the environment. Machine and global groups are deleted before the
+// model's default group, so that no rules are left behind in the account
+// once the model is gone. A failure to delete the default group is
+// reported in preference to failures to delete the others.
 func (e *environ) cleanEnvironmentSecurityGroups() error {
+	groups, err := e.modelSecurityGroups()
+	if err != nil {
+		return errors.Trace(err)
+	}
 	jujuGroup := e.jujuGroupName()
+	var failed []string
+	for _, g := range groups {
+		if g.Name == jujuGroup {
+			continue
+		}
+		if err := deleteSecurityGroupInsistently(e.ec2, g, clock.WallClock); err != nil {
+			logger.Warningf("cannot delete security group %q: %v", g.Name, err)
+			failed = append(failed, g.Name)
+		}
+	}
+
+	// The default group is looked up by name, rather than by tag, as
+	// groups created by older versions of juju may not be tagged.
 	g, err := e.groupByName(jujuGroup)
 	if isNotFoundError(err) {
 		return nil
@@ -1284,6 +1323,9 @@ func (e *environ) cleanEnvironmentSecurityGroups() error {
 	if err := deleteSecurityGroupInsistently(e.ec2, g, clock.WallClock); err != nil {
 		return errors.Annotate(err, "cannot delete default security group")
 	}
+	if len(failed) > 0 {
+		return errors.Errorf("cannot delete security groups %s", strings.Join(failed, ", "))
+	}
 	return nil
 }
 
@@ -1427,6 +1469,9 @@ func (e *environ) uuid() string {
 	return e.Config().UUID()
 }
 
+// globalGroupName returns the name of the security group holding the
+// rules for the ports opened in global firewall mode. The name includes
+// the model UUID, so models sharing an account do not share rules.
 func (e *environ) globalGroupName() string {
 	return fmt.Sprintf("%s-global", e.jujuGroupName())
 }
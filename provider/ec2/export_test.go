@@ -4,8 +4,11 @@
 package ec2
 
 import (
+	"net/http"
+	"net/url"
 	"strings"
 
+	jc "github.com/juju/testing/checkers"
 	"gopkg.in/amz.v3/aws"
 	"gopkg.in/amz.v3/ec2"
 	gc "gopkg.in/check.v1"
@@ -49,6 +52,7 @@ var (
 	EC2AvailabilityZones        = &ec2AvailabilityZones
 	AvailabilityZoneAllocations = &availabilityZoneAllocations
 	RunInstances                = &runInstances
+	RunSpotInstances            = &runSpotInstances
 	BlockDeviceNamer            = blockDeviceNamer
 	GetBlockDeviceMappings      = getBlockDeviceMappings
 	IsVPCNotUsableError         = isVPCNotUsableError
@@ -57,6 +61,17 @@ var (
 
 const VPCIDNone = vpcIDNone
 
+// SignedRunParams returns the query parameters of a RunInstances
+// request signed by the given client, as a way of checking the
+// parameters added by withRunParams.
+func SignedRunParams(c *gc.C, client *ec2.EC2) url.Values {
+	req, err := http.NewRequest("GET", client.Region.EC2Endpoint+"?Action=RunInstances", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = client.Sign(req, client.Auth)
+	c.Assert(err, jc.ErrorIsNil)
+	return req.URL.Query()
+}
+
 // TODO: Apart from overriding different hardcoded hosts, these two test helpers are identical. Let's share.
 
 // UseTestImageData causes the given content to be served
@@ -43,13 +43,28 @@ func (inst *ec2Instance) Status() instance.InstanceStatus {
 	default:
 		jujuStatus = status.Empty
 	}
+	message := inst.State.Name
+	if inst.market() == instanceMarketSpot {
+		message += " (spot)"
+	}
 	return instance.InstanceStatus{
 		Status:  jujuStatus,
-		Message: inst.State.Name,
+		Message: message,
 	}
 
 }
 
+// market returns the market the instance was started in, as recorded
+// in its tags, or "" if it is not known.
+func (inst *ec2Instance) market() string {
+	for _, tag := range inst.Tags {
+		if tag.Key == tagInstanceMarket {
+			return tag.Value
+		}
+	}
+	return ""
+}
+
 // Addresses implements network.Addresses() returning generic address
 // details for the instance, and requerying the ec2 api if required.
 func (inst *ec2Instance) Addresses() ([]network.Address, error) {
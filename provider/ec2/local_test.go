@@ -5,6 +5,7 @@ package ec2_test
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
 	"sort"
 	"strconv"
@@ -618,6 +619,48 @@ func (t *localServerSuite) TestDestroyHostedModelDeleteSecurityGroupInsistentlyE
 	c.Assert(err, gc.ErrorMatches, "cannot delete environment security groups: cannot delete default security group: "+msg)
 }
 
+func (t *localServerSuite) TestDestroyHostedModelDeletesModelSecurityGroups(c *gc.C) {
+	controllerEnv := t.prepareAndBootstrap(c)
+
+	hostedModelUUID := "7e386e08-cba7-44a4-a76e-7c1633584210"
+	cfg, err := controllerEnv.Config().Apply(map[string]interface{}{
+		"uuid":          hostedModelUUID,
+		"firewall-mode": "global",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	env, err := environs.New(environs.OpenParams{
+		Cloud:  t.CloudSpec(),
+		Config: cfg,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	testing.AssertStartInstance(c, env, t.ControllerUUID, "0")
+
+	assertGroups := func(expect ...string) {
+		groupsResp, err := t.client.SecurityGroups(nil, nil)
+		c.Assert(err, jc.ErrorIsNil)
+		names := make([]string, len(groupsResp.Groups))
+		for i, group := range groupsResp.Groups {
+			names[i] = group.Name
+		}
+		c.Assert(names, jc.SameContents, expect)
+	}
+	controllerGroups := []string{
+		"default",
+		"juju-" + controllerEnv.Config().UUID(),
+		"juju-" + controllerEnv.Config().UUID() + "-0",
+	}
+	assertGroups(append(controllerGroups,
+		"juju-"+hostedModelUUID,
+		"juju-"+hostedModelUUID+"-global",
+	)...)
+
+	// Destroying the hosted model must remove all of its groups,
+	// leaving the controller model's groups untouched.
+	err = env.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+	assertGroups(controllerGroups...)
+}
+
 func (t *localServerSuite) TestDestroyControllerDestroysHostedModelResources(c *gc.C) {
 	controllerEnv := t.prepareAndBootstrap(c)
 
@@ -716,6 +759,52 @@ func splitAuthKeys(keys string) []interface{} {
 	return lines
 }
 
+func (t *localServerSuite) TestStartInstanceSetsMetadataOptions(c *gc.C) {
+	env := t.prepareAndBootstrap(c)
+	var params []url.Values
+	t.BaseSuite.PatchValue(ec2.RunInstances, func(e *amzec2.EC2, ri *amzec2.RunInstances) (*amzec2.RunInstancesResp, error) {
+		params = append(params, ec2.SignedRunParams(c, e))
+		return e.RunInstances(ri)
+	})
+
+	testing.AssertStartInstance(c, env, t.ControllerUUID, "1")
+	c.Assert(params, gc.HasLen, 1)
+	c.Check(params[0].Get("MetadataOptions.HttpEndpoint"), gc.Equals, "enabled")
+	c.Check(params[0].Get("MetadataOptions.HttpTokens"), gc.Equals, "required")
+	c.Check(params[0].Get("MetadataOptions.HttpPutResponseHopLimit"), gc.Equals, "2")
+}
+
+func (t *localServerSuite) TestStartInstanceIAMInstanceProfile(c *gc.C) {
+	env := t.prepareAndBootstrap(c)
+	cfg, err := env.Config().Apply(map[string]interface{}{
+		"iam-instance-profile": "juju-workload-profile",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = env.SetConfig(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	var profiles []string
+	t.BaseSuite.PatchValue(ec2.RunInstances, func(e *amzec2.EC2, ri *amzec2.RunInstances) (*amzec2.RunInstancesResp, error) {
+		profiles = append(profiles, ri.IAMInstanceProfile)
+		return e.RunInstances(ri)
+	})
+
+	testing.AssertStartInstance(c, env, t.ControllerUUID, "1")
+	c.Assert(profiles, jc.DeepEquals, []string{"juju-workload-profile"})
+}
+
+func (t *localServerSuite) TestStartInstanceNoIAMInstanceProfile(c *gc.C) {
+	env := t.prepareAndBootstrap(c)
+	var profiles []string
+	t.BaseSuite.PatchValue(ec2.RunInstances, func(e *amzec2.EC2, ri *amzec2.RunInstances) (*amzec2.RunInstancesResp, error) {
+		profiles = append(profiles, ri.IAMInstanceProfile)
+		return e.RunInstances(ri)
+	})
+
+	testing.AssertStartInstance(c, env, t.ControllerUUID, "1")
+	c.Assert(profiles, jc.DeepEquals, []string{""})
+}
+
 func (t *localServerSuite) TestInstanceStatus(c *gc.C) {
 	env := t.Prepare(c)
 	err := bootstrap.Bootstrap(envtesting.BootstrapContext(c), env, bootstrap.BootstrapParams{
@@ -1445,7 +1534,72 @@ func (t *localServerSuite) TestInstanceTags(c *gc.C) {
 		{"juju-model-uuid", coretesting.ModelTag.Id()},
 		{"juju-controller-uuid", t.ControllerUUID},
 		{"juju-is-controller", "true"},
+		{"juju-instance-market", "on-demand"},
+	})
+}
+
+func (t *localServerSuite) prepareSpotEnviron(c *gc.C) environs.Environ {
+	env := t.prepareAndBootstrap(c)
+	cfg, err := env.Config().Apply(map[string]interface{}{
+		"instance-market": "spot",
+		"spot-max-price":  "0.05",
 	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = env.SetConfig(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	return env
+}
+
+func (t *localServerSuite) assertInstanceMarket(c *gc.C, env environs.Environ, id instance.Id, market string) {
+	instances, err := env.Instances([]instance.Id{id})
+	c.Assert(err, jc.ErrorIsNil)
+	var tagged string
+	for _, tag := range ec2.InstanceEC2(instances[0]).Tags {
+		if tag.Key == "juju-instance-market" {
+			tagged = tag.Value
+		}
+	}
+	c.Assert(tagged, gc.Equals, market)
+	if market == "spot" {
+		c.Assert(instances[0].Status().Message, gc.Matches, `.* \(spot\)`)
+	} else {
+		c.Assert(instances[0].Status().Message, gc.Not(gc.Matches), `.* \(spot\)`)
+	}
+}
+
+func (t *localServerSuite) TestStartInstanceSpot(c *gc.C) {
+	env := t.prepareSpotEnviron(c)
+	var maxPrices []string
+	t.BaseSuite.PatchValue(ec2.RunSpotInstances, func(e *amzec2.EC2, ri *amzec2.RunInstances, maxPrice string) (*amzec2.RunInstancesResp, error) {
+		// The ec2test server has no notion of markets, so
+		// start the instance as usual.
+		maxPrices = append(maxPrices, maxPrice)
+		return e.RunInstances(ri)
+	})
+
+	inst, _ := testing.AssertStartInstance(c, env, t.ControllerUUID, "1")
+	c.Assert(maxPrices, jc.DeepEquals, []string{"0.05"})
+	t.assertInstanceMarket(c, env, inst.Id(), "spot")
+}
+
+func (t *localServerSuite) TestStartInstanceSpotFallsBackToOnDemand(c *gc.C) {
+	env := t.prepareSpotEnviron(c)
+	t.BaseSuite.PatchValue(ec2.RunSpotInstances, func(*amzec2.EC2, *amzec2.RunInstances, string) (*amzec2.RunInstancesResp, error) {
+		return nil, &amzec2.Error{Code: "SpotMaxPriceTooLow"}
+	})
+
+	inst, _ := testing.AssertStartInstance(c, env, t.ControllerUUID, "1")
+	t.assertInstanceMarket(c, env, inst.Id(), "on-demand")
+}
+
+func (t *localServerSuite) TestStartInstanceSpotError(c *gc.C) {
+	env := t.prepareSpotEnviron(c)
+	t.BaseSuite.PatchValue(ec2.RunSpotInstances, func(*amzec2.EC2, *amzec2.RunInstances, string) (*amzec2.RunInstancesResp, error) {
+		return nil, &amzec2.Error{Code: "Blocked", Message: "account blocked"}
+	})
+
+	_, _, _, err := testing.StartInstance(env, t.ControllerUUID, "1")
+	c.Assert(err, gc.ErrorMatches, "cannot run instances: account blocked.*")
 }
 
 func (t *localServerSuite) TestRootDiskTags(c *gc.C) {
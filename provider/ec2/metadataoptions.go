@@ -0,0 +1,40 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ec2
+
+import (
+	"strconv"
+)
+
+const (
+	// metadataTokensRequired requires that requests to the instance
+	// metadata service carry a session token (IMDSv2).
+	metadataTokensRequired = "required"
+
+	// metadataTokensOptional allows token-less (IMDSv1) requests to
+	// the instance metadata service.
+	metadataTokensOptional = "optional"
+)
+
+// instanceMetadataOptions holds the instance metadata service settings
+// applied to each instance started by the provider.
+type instanceMetadataOptions struct {
+	// HTTPTokens is either "required" or "optional".
+	HTTPTokens string
+
+	// HopLimit is the maximum number of network hops a metadata
+	// response may travel; containers need at least 2.
+	HopLimit int
+}
+
+// runParams returns the RunInstances parameters that configure the
+// instance metadata service, so that instances boot with it already
+// hardened.
+func (opts instanceMetadataOptions) runParams() map[string]string {
+	return map[string]string{
+		"MetadataOptions.HttpEndpoint":            "enabled",
+		"MetadataOptions.HttpTokens":              opts.HTTPTokens,
+		"MetadataOptions.HttpPutResponseHopLimit": strconv.Itoa(opts.HopLimit),
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ec2
+
+import (
+	"net/http"
+
+	"gopkg.in/amz.v3/aws"
+	"gopkg.in/amz.v3/ec2"
+)
+
+// runParamsAPIVersion is the EC2 API version used for requests made by
+// clients returned from withRunParams. It is the first version to support
+// instance market and metadata options.
+const runParamsAPIVersion = "2016-11-15"
+
+// withRunParams returns a copy of the given client that adds params to
+// each request it makes. It is used to pass RunInstances options that
+// the amz.v3 client has no fields for, while still letting amz.v3
+// serialise the request and parse the response.
+//
+// The requests are made with runParamsAPIVersion, as the version used
+// by amz.v3 predates the options.
+func withRunParams(e *ec2.EC2, params map[string]string) *ec2.EC2 {
+	client := *e
+	sign := e.Sign
+	client.Sign = func(req *http.Request, auth aws.Auth) error {
+		query := req.URL.Query()
+		for name, value := range params {
+			query.Set(name, value)
+		}
+		query.Set("Version", runParamsAPIVersion)
+		req.URL.RawQuery = query.Encode()
+		return sign(req, auth)
+	}
+	return &client
+}
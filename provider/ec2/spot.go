@@ -0,0 +1,83 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ec2
+
+import (
+	"gopkg.in/amz.v3/ec2"
+)
+
+const (
+	// instanceMarketOnDemand is the market for instances billed at
+	// the regular on-demand price.
+	instanceMarketOnDemand = "on-demand"
+
+	// instanceMarketSpot is the market for instances run on spare
+	// capacity, which may be interrupted by EC2.
+	instanceMarketSpot = "spot"
+
+	// tagInstanceMarket is the tag recording which market an
+	// instance was started in.
+	tagInstanceMarket = "juju-instance-market"
+)
+
+var runSpotInstances = _runSpotInstances
+
+// _runSpotInstances calls RunInstances with the given arguments, requesting
+// one-time spot capacity at no more than maxPrice (in USD per hour). If
+// maxPrice is empty, the on-demand price is used as the maximum. As with
+// runInstances, the call is retried while the error may be caused by
+// eventual consistency.
+func _runSpotInstances(e *ec2.EC2, ri *ec2.RunInstances, maxPrice string) (*ec2.RunInstancesResp, error) {
+	return runInstances(withRunParams(e, spotParams(maxPrice)), ri)
+}
+
+// spotParams returns the RunInstances parameters that request one-time
+// spot capacity at no more than maxPrice.
+func spotParams(maxPrice string) map[string]string {
+	params := map[string]string{
+		"InstanceMarketOptions.MarketType":                               "spot",
+		"InstanceMarketOptions.SpotOptions.SpotInstanceType":             "one-time",
+		"InstanceMarketOptions.SpotOptions.InstanceInterruptionBehavior": "terminate",
+	}
+	if maxPrice != "" {
+		params["InstanceMarketOptions.SpotOptions.MaxPrice"] = maxPrice
+	}
+	return params
+}
+
+// runInstancesInMarket starts instances in the model's configured market,
+// returning the market the instances were started in. If spot capacity is
+// requested but not available, on-demand instances are started instead.
+// Either way, the instances are launched with the model's instance
+// metadata options.
+func (e *environ) runInstancesInMarket(ri *ec2.RunInstances) (*ec2.RunInstancesResp, string, error) {
+	ecfg := e.ecfg()
+	client := withRunParams(e.ec2, ecfg.instanceMetadataOptions().runParams())
+	if ecfg.instanceMarket() == instanceMarketSpot {
+		resp, err := runSpotInstances(client, ri, ecfg.spotMaxPrice())
+		if err == nil {
+			return resp, instanceMarketSpot, nil
+		}
+		if !isSpotUnavailableError(err) {
+			return nil, "", err
+		}
+		logger.Infof("spot capacity unavailable, falling back to on-demand: %v", err)
+	}
+	resp, err := runInstances(client, ri)
+	return resp, instanceMarketOnDemand, err
+}
+
+// isSpotUnavailableError reports whether the error indicates that spot
+// capacity could not be obtained at the requested price.
+func isSpotUnavailableError(err error) bool {
+	switch ec2ErrCode(err) {
+	case "InsufficientInstanceCapacity",
+		"InsufficientCapacity",
+		"SpotMaxPriceTooLow",
+		"MaxSpotInstanceCountExceeded",
+		"UnfulfillableCapacity":
+		return true
+	}
+	return false
+}
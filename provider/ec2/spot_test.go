@@ -0,0 +1,90 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ec2
+
+import (
+	"net/http"
+
+	jc "github.com/juju/testing/checkers"
+	"gopkg.in/amz.v3/aws"
+	"gopkg.in/amz.v3/ec2"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/testing"
+)
+
+type SpotSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&SpotSuite{})
+
+// signedQuery returns the query of a request signed by the given
+// client.
+func signedQuery(c *gc.C, e *ec2.EC2) map[string][]string {
+	req, err := http.NewRequest("GET", "https://ec2.example.com/?Action=RunInstances&Version=2011-12-15", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = e.Sign(req, aws.Auth{})
+	c.Assert(err, jc.ErrorIsNil)
+	return req.URL.Query()
+}
+
+func (*SpotSuite) TestWithRunParams(c *gc.C) {
+	var signed int
+	e := ec2.New(aws.Auth{}, aws.Regions["us-east-1"], func(*http.Request, aws.Auth) error {
+		signed++
+		return nil
+	})
+	client := withRunParams(e, map[string]string{"Extra.Option": "value"})
+	c.Assert(signedQuery(c, client), jc.DeepEquals, map[string][]string{
+		"Action":       {"RunInstances"},
+		"Version":      {runParamsAPIVersion},
+		"Extra.Option": {"value"},
+	})
+	c.Assert(signed, gc.Equals, 1)
+
+	// The original client is left alone.
+	c.Assert(signedQuery(c, e), jc.DeepEquals, map[string][]string{
+		"Action":  {"RunInstances"},
+		"Version": {"2011-12-15"},
+	})
+}
+
+func (*SpotSuite) TestSpotParams(c *gc.C) {
+	c.Assert(spotParams("0.05"), jc.DeepEquals, map[string]string{
+		"InstanceMarketOptions.MarketType":                               "spot",
+		"InstanceMarketOptions.SpotOptions.SpotInstanceType":             "one-time",
+		"InstanceMarketOptions.SpotOptions.InstanceInterruptionBehavior": "terminate",
+		"InstanceMarketOptions.SpotOptions.MaxPrice":                     "0.05",
+	})
+	_, ok := spotParams("")["InstanceMarketOptions.SpotOptions.MaxPrice"]
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *SpotSuite) TestRunSpotInstancesUsesRunInstances(c *gc.C) {
+	e := ec2.New(aws.Auth{}, aws.Regions["us-east-1"], func(*http.Request, aws.Auth) error {
+		return nil
+	})
+	ri := &ec2.RunInstances{ImageId: "ami-0001"}
+	expectResp := &ec2.RunInstancesResp{}
+	var called int
+	s.PatchValue(&runInstances, func(client *ec2.EC2, args *ec2.RunInstances) (*ec2.RunInstancesResp, error) {
+		called++
+		c.Assert(args, gc.Equals, ri)
+		query := signedQuery(c, client)
+		c.Assert(query["InstanceMarketOptions.MarketType"], jc.DeepEquals, []string{"spot"})
+		c.Assert(query["InstanceMarketOptions.SpotOptions.MaxPrice"], jc.DeepEquals, []string{"0.05"})
+		return expectResp, nil
+	})
+	resp, err := runSpotInstances(e, ri, "0.05")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(resp, gc.Equals, expectResp)
+	c.Assert(called, gc.Equals, 1)
+}
+
+func (*SpotSuite) TestIsSpotUnavailableError(c *gc.C) {
+	c.Assert(isSpotUnavailableError(&ec2.Error{Code: "SpotMaxPriceTooLow"}), jc.IsTrue)
+	c.Assert(isSpotUnavailableError(&ec2.Error{Code: "InsufficientInstanceCapacity"}), jc.IsTrue)
+	c.Assert(isSpotUnavailableError(&ec2.Error{Code: "AuthFailure"}), jc.IsFalse)
+}
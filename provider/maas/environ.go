@@ -1409,6 +1409,15 @@ func (environ *maasEnviron) newCloudinitConfig(hostname, forSeries string, inter
 			)
 			break
 		}
+		// With no interfaces linked to subnets there is nothing to
+		// bridge, and the script would fail the whole of cloud-init.
+		if len(interfacesToBridge) == 0 {
+			logger.Infof(
+				"no interfaces to bridge - not using %q bridge for containers",
+				instancecfg.DefaultBridgeName,
+			)
+			break
+		}
 		cloudcfg.AddPackage("bridge-utils")
 		cloudcfg.AddBootTextFile(bridgeScriptPath, bridgeScriptPython, 0755)
 		cloudcfg.AddScripts(setupJujuNetworking(interfacesToBridge))
@@ -168,6 +168,17 @@ func (*environSuite) TestNewCloudinitConfigWithDisabledNetworkManagement(c *gc.C
 	c.Assert(cloudcfg.RunCmds(), jc.DeepEquals, expectedCloudinitConfig)
 }
 
+func (*environSuite) TestNewCloudinitConfigWithNoInterfacesToBridge(c *gc.C) {
+	cfg := getSimpleTestConfig(c, nil)
+	env, err := maas.NewEnviron(getSimpleCloudSpec(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	cloudcfg, err := maas.NewCloudinitConfig(env, "testing.invalid", "quantal", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cloudcfg.SystemUpdate(), jc.IsTrue)
+	c.Assert(cloudcfg.RunCmds(), jc.DeepEquals, expectedCloudinitConfig)
+	c.Assert(cloudcfg.Packages(), gc.HasLen, 0)
+}
+
 func (*environSuite) TestRenderEtcNetworkInterfacesScriptMultipleNames(c *gc.C) {
 	script := maas.RenderEtcNetworkInterfacesScript("eth0", "eth0:1", "eth2", "eth1")
 	c.Check(script, jc.Contains, `--interfaces-to-bridge="eth0 eth0:1 eth2 eth1"`)
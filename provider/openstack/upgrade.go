@@ -10,20 +10,50 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/version"
 	"gopkg.in/goose.v1/nova"
+
+	"github.com/juju/juju/environs"
 )
 
-// RunUpgradeStepsFor implements provider.Upgradable
-func (e *Environ) RunUpgradeStepsFor(ver version.Number) error {
-	switch ver {
-	case version.Number{Major: 1, Minor: 26}:
-		if err := addUUIDToSecurityGroupNames(e); err != nil {
-			return errors.Annotate(err, "upgrading security group names in upgrade step for version 1.26")
-		}
-		if err := addUUIDToMachineNames(e); err != nil {
-			return errors.Annotate(err, "upgrading security machine names in upgrade step for version 1.26")
-		}
-	}
-	return nil
+// uuidNamesVersion is the version in which the model UUID replaced the
+// model name in security group and machine names.
+var uuidNamesVersion = version.Number{Major: 1, Minor: 26}
+
+// UpgradeTargetVersions is part of the environs.UpgradeVersioner interface.
+func (EnvironProvider) UpgradeTargetVersions() []version.Number {
+	return []version.Number{uuidNamesVersion}
+}
+
+// UpgradeOperations is part of the environs.Upgrader interface.
+func (e *Environ) UpgradeOperations(environs.UpgradeOperationsParams) []environs.UpgradeOperation {
+	return []environs.UpgradeOperation{{
+		TargetVersion: uuidNamesVersion,
+		Steps: []environs.UpgradeStep{
+			upgradeStep{
+				"add model UUID to security group names",
+				func() error { return addUUIDToSecurityGroupNames(e) },
+			},
+			upgradeStep{
+				"add model UUID to machine names",
+				func() error { return addUUIDToMachineNames(e) },
+			},
+		},
+	}}
+}
+
+// upgradeStep is an environs.UpgradeStep implemented by a function.
+type upgradeStep struct {
+	description string
+	run         func() error
+}
+
+// Description is part of the environs.UpgradeStep interface.
+func (s upgradeStep) Description() string {
+	return s.description
+}
+
+// Run is part of the environs.UpgradeStep interface.
+func (s upgradeStep) Run() error {
+	return s.run()
 }
 
 func replaceNameWithID(oldName, envName, eUUID string) (string, bool, error) {
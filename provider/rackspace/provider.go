@@ -6,6 +6,8 @@ package rackspace
 import (
 	"strings"
 
+	"github.com/juju/errors"
+
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
 )
@@ -28,6 +30,16 @@ func (p *environProvider) Open(args environs.OpenParams) (environs.Environ, erro
 	return p.EnvironProvider.Open(args)
 }
 
+// Validate is part of the EnvironProvider interface.
+func (p *environProvider) Validate(cfg, old *config.Config) (*config.Config, error) {
+	if cfg.FirewallMode() == config.FwGlobal {
+		// Rackspace security groups can only be applied
+		// per instance, so "global" mode is not supported.
+		return nil, errors.NotSupportedf("firewall-mode %q", config.FwGlobal)
+	}
+	return p.EnvironProvider.Validate(cfg, old)
+}
+
 func transformCloudSpec(spec environs.CloudSpec) environs.CloudSpec {
 	// Rackspace regions are expected to be uppercase, but Juju
 	// stores and displays them in lowercase in the CLI. Ensure
@@ -41,6 +41,22 @@ func (s *providerSuite) TestValidate(c *gc.C) {
 	s.innerProvider.CheckCallNames(c, "Validate")
 }
 
+func (s *providerSuite) TestValidateGlobalFirewallMode(c *gc.C) {
+	cfg, err := config.New(config.UseDefaults, map[string]interface{}{
+		"name":            "some-name",
+		"type":            "some-type",
+		"uuid":            coretesting.ModelTag.Id(),
+		"controller-uuid": coretesting.ControllerTag.Id(),
+		"authorized-keys": "key",
+		"firewall-mode":   config.FwGlobal,
+	})
+	c.Check(err, gc.IsNil)
+	_, err = s.provider.Validate(cfg, nil)
+	c.Check(err, gc.ErrorMatches, `firewall-mode "global" not supported`)
+	c.Check(errors.IsNotSupported(err), gc.Equals, true)
+	s.innerProvider.CheckNoCalls(c)
+}
+
 func (s *providerSuite) TestPrepareConfig(c *gc.C) {
 	args := environs.PrepareConfigParams{
 		Cloud: environs.CloudSpec{
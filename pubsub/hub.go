@@ -0,0 +1,163 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package pubsub provides a simple in-memory publish/subscribe hub
+// used to distribute events between workers on a controller, and to
+// forward those events to the other controllers in an HA cluster.
+package pubsub
+
+import (
+	"sync"
+
+	"github.com/juju/loggo"
+)
+
+var logger = loggo.GetLogger("juju.pubsub")
+
+// APIDetailsTopic is the topic used to publish the API addresses of
+// the controller machines, whenever they change. The "servers" key
+// holds a list of host:port addresses for each controller.
+const APIDetailsTopic = "apiserver.details"
+
+// Message is a single event published on a hub.
+type Message struct {
+	// Topic holds the topic the message was published on.
+	Topic string `json:"topic"`
+
+	// Origin identifies the controller on which the
+	// message was first published.
+	Origin string `json:"origin"`
+
+	// Data holds the message payload.
+	Data map[string]interface{} `json:"data,omitempty"`
+}
+
+// Hub distributes published messages to its subscribers. Handlers are
+// called asynchronously, each subscriber receiving messages in the
+// order in which they were published.
+type Hub struct {
+	origin string
+
+	mu          sync.Mutex
+	nextID      int
+	subscribers map[int]*subscriber
+}
+
+// NewHub returns a new hub that marks the messages published
+// on it as originating from the given origin.
+func NewHub(origin string) *Hub {
+	return &Hub{
+		origin:      origin,
+		subscribers: make(map[int]*subscriber),
+	}
+}
+
+// Origin returns the origin of messages published on the hub.
+func (h *Hub) Origin() string {
+	return h.origin
+}
+
+// Publish publishes the given data on the given topic,
+// originating from this hub.
+func (h *Hub) Publish(topic string, data map[string]interface{}) {
+	h.PublishMessage(Message{
+		Topic:  topic,
+		Origin: h.origin,
+		Data:   data,
+	})
+}
+
+// PublishMessage publishes the given message, keeping its origin. It
+// is used to republish messages forwarded from other controllers.
+func (h *Hub) PublishMessage(m Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, s := range h.subscribers {
+		if s.topic == "" || s.topic == m.Topic {
+			s.enqueue(m)
+		}
+	}
+}
+
+// Subscribe arranges for the given handler to be called with every
+// message published on the given topic; an empty topic matches all
+// messages. The returned function stops the subscription; no further
+// handler calls are started once it has been called.
+func (h *Hub) Subscribe(topic string, handler func(Message)) func() {
+	s := &subscriber{
+		topic:   topic,
+		handler: handler,
+		data:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	h.mu.Lock()
+	id := h.nextID
+	h.nextID++
+	h.subscribers[id] = s
+	h.mu.Unlock()
+	go s.loop()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subscribers, id)
+			h.mu.Unlock()
+			close(s.done)
+		})
+	}
+}
+
+type subscriber struct {
+	topic   string
+	handler func(Message)
+
+	mu      sync.Mutex
+	pending []Message
+	data    chan struct{}
+	done    chan struct{}
+}
+
+func (s *subscriber) enqueue(m Message) {
+	s.mu.Lock()
+	s.pending = append(s.pending, m)
+	s.mu.Unlock()
+	select {
+	case s.data <- struct{}{}:
+	default:
+	}
+}
+
+func (s *subscriber) next() (Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.pending) == 0 {
+		return Message{}, false
+	}
+	m := s.pending[0]
+	s.pending = s.pending[1:]
+	return m, true
+}
+
+func (s *subscriber) loop() {
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-s.data:
+		}
+		for {
+			m, ok := s.next()
+			if !ok {
+				break
+			}
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+			logger.Tracef("delivering %q message from %q", m.Topic, m.Origin)
+			s.handler(m)
+		}
+	}
+}
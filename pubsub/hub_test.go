@@ -0,0 +1,124 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pubsub_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/pubsub"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type hubSuite struct{}
+
+var _ = gc.Suite(&hubSuite{})
+
+func (*hubSuite) TestPublishSetsOrigin(c *gc.C) {
+	hub := pubsub.NewHub("machine-0")
+	messages := make(chan pubsub.Message, 1)
+	unsubscribe := hub.Subscribe("topic", func(m pubsub.Message) {
+		messages <- m
+	})
+	defer unsubscribe()
+
+	hub.Publish("topic", map[string]interface{}{"key": "value"})
+	c.Assert(nextMessage(c, messages), jc.DeepEquals, pubsub.Message{
+		Topic:  "topic",
+		Origin: "machine-0",
+		Data:   map[string]interface{}{"key": "value"},
+	})
+}
+
+func (*hubSuite) TestPublishMessageKeepsOrigin(c *gc.C) {
+	hub := pubsub.NewHub("machine-0")
+	messages := make(chan pubsub.Message, 1)
+	unsubscribe := hub.Subscribe("topic", func(m pubsub.Message) {
+		messages <- m
+	})
+	defer unsubscribe()
+
+	hub.PublishMessage(pubsub.Message{Topic: "topic", Origin: "machine-1"})
+	c.Assert(nextMessage(c, messages).Origin, gc.Equals, "machine-1")
+}
+
+func (*hubSuite) TestSubscribeFiltersTopic(c *gc.C) {
+	hub := pubsub.NewHub("machine-0")
+	messages := make(chan pubsub.Message, 2)
+	unsubscribe := hub.Subscribe("wanted", func(m pubsub.Message) {
+		messages <- m
+	})
+	defer unsubscribe()
+
+	hub.Publish("unwanted", nil)
+	hub.Publish("wanted", nil)
+	c.Assert(nextMessage(c, messages).Topic, gc.Equals, "wanted")
+	assertNoMessage(c, messages)
+}
+
+func (*hubSuite) TestSubscribeAllTopics(c *gc.C) {
+	hub := pubsub.NewHub("machine-0")
+	messages := make(chan pubsub.Message, 2)
+	unsubscribe := hub.Subscribe("", func(m pubsub.Message) {
+		messages <- m
+	})
+	defer unsubscribe()
+
+	hub.Publish("one", nil)
+	hub.Publish("two", nil)
+	c.Assert(nextMessage(c, messages).Topic, gc.Equals, "one")
+	c.Assert(nextMessage(c, messages).Topic, gc.Equals, "two")
+}
+
+func (*hubSuite) TestSlowHandlerDoesNotBlockPublish(c *gc.C) {
+	hub := pubsub.NewHub("machine-0")
+	release := make(chan struct{})
+	messages := make(chan pubsub.Message, 3)
+	unsubscribe := hub.Subscribe("topic", func(m pubsub.Message) {
+		<-release
+		messages <- m
+	})
+	defer unsubscribe()
+
+	for _, value := range []string{"a", "b", "c"} {
+		hub.Publish("topic", map[string]interface{}{"value": value})
+	}
+	close(release)
+	for _, value := range []string{"a", "b", "c"} {
+		c.Assert(nextMessage(c, messages).Data["value"], gc.Equals, value)
+	}
+}
+
+func (*hubSuite) TestUnsubscribe(c *gc.C) {
+	hub := pubsub.NewHub("machine-0")
+	messages := make(chan pubsub.Message, 1)
+	unsubscribe := hub.Subscribe("topic", func(m pubsub.Message) {
+		messages <- m
+	})
+	unsubscribe()
+	unsubscribe()
+
+	hub.Publish("topic", nil)
+	assertNoMessage(c, messages)
+}
+
+func nextMessage(c *gc.C, messages <-chan pubsub.Message) pubsub.Message {
+	select {
+	case m := <-messages:
+		return m
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for message")
+	}
+	panic("unreachable")
+}
+
+func assertNoMessage(c *gc.C, messages <-chan pubsub.Message) {
+	select {
+	case m := <-messages:
+		c.Fatalf("unexpected message %#v", m)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/cmd/modelcmd"
 )
@@ -75,9 +76,12 @@ func (c *UploadCommand) Init(args []string) error {
 	}
 
 	service := args[0]
-	if service == "" { // TODO(ericsnow) names.IsValidApplication
+	if service == "" {
 		return errors.NewNotValid(nil, "missing application name")
 	}
+	if !names.IsValidApplication(service) {
+		return errors.NotValidf("application name %q", service)
+	}
 	c.service = service
 
 	if err := c.addResourceFile(args[1]); err != nil {
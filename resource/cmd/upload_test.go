@@ -64,6 +64,14 @@ func (*UploadSuite) TestInitNoPath(c *gc.C) {
 	c.Assert(errors.Cause(err), jc.Satisfies, errors.IsNotValid)
 }
 
+func (*UploadSuite) TestInitBadApplication(c *gc.C) {
+	var u UploadCommand
+
+	err := u.Init([]string{"foo-1", "bar=baz"})
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	c.Assert(err, gc.ErrorMatches, `application name "foo-1" not valid`)
+}
+
 func (*UploadSuite) TestInitGood(c *gc.C) {
 	var u UploadCommand
 
@@ -5,6 +5,8 @@ package service
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/juju/errors"
 	"github.com/juju/utils/shell"
@@ -41,9 +43,32 @@ func AgentConf(info AgentInfo, renderer shell.Renderer) common.Conf {
 		conf.Desc = "juju unit agent for " + info.ID
 	}
 
+	if info.LogFormat != "" {
+		conf.Env = osenv.MergeEnvironment(conf.Env, logEnvironment(info))
+	}
+
 	return conf
 }
 
+// logEnvironment returns the environment variables that configure
+// the format of the agent's log output, along with the entity and
+// labels recorded in it.
+func logEnvironment(info AgentInfo) map[string]string {
+	env := map[string]string{
+		osenv.JujuLogFormatEnvKey: info.LogFormat,
+		osenv.JujuLogEntityEnvKey: info.name,
+	}
+	if len(info.LogLabels) > 0 {
+		labels := make([]string, 0, len(info.LogLabels))
+		for key, value := range info.LogLabels {
+			labels = append(labels, key+"="+value)
+		}
+		sort.Strings(labels)
+		env[osenv.JujuLogLabelsEnvKey] = strings.Join(labels, ",")
+	}
+	return env
+}
+
 // TODO(ericsnow) Eliminate ContainerAgentConf once it is no longer
 // used in worker/deployer/simple.go.
 
@@ -218,6 +218,39 @@ func (*agentSuite) TestContainerAgentConf(c *gc.C) {
 	})
 }
 
+func (*agentSuite) TestAgentConfLogFormat(c *gc.C) {
+	info := service.NewUnitAgentInfo("wordpress/0", c.MkDir(), c.MkDir())
+	info.LogFormat = "json"
+	info.LogLabels = map[string]string{
+		"model":  "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+		"region": "us-east-1",
+	}
+	renderer, err := shell.NewRenderer("")
+	c.Assert(err, jc.ErrorIsNil)
+	conf := service.AgentConf(info, renderer)
+
+	c.Check(conf.Env[osenv.JujuLogFormatEnvKey], gc.Equals, "json")
+	c.Check(conf.Env[osenv.JujuLogEntityEnvKey], gc.Equals, "unit-wordpress-0")
+	c.Check(conf.Env[osenv.JujuLogLabelsEnvKey], gc.Equals,
+		"model=deadbeef-0bad-400d-8000-4b1d0d06f00d,region=us-east-1")
+}
+
+func (*agentSuite) TestAgentConfDefaultLogFormat(c *gc.C) {
+	info := service.NewMachineAgentInfo("0", c.MkDir(), c.MkDir())
+	renderer, err := shell.NewRenderer("")
+	c.Assert(err, jc.ErrorIsNil)
+	conf := service.AgentConf(info, renderer)
+
+	for _, key := range []string{
+		osenv.JujuLogFormatEnvKey,
+		osenv.JujuLogEntityEnvKey,
+		osenv.JujuLogLabelsEnvKey,
+	} {
+		_, ok := conf.Env[key]
+		c.Check(ok, jc.IsFalse, gc.Commentf("%s", key))
+	}
+}
+
 func (*agentSuite) TestShutdownAfterConf(c *gc.C) {
 	conf, err := service.ShutdownAfterConf("spam")
 	c.Assert(err, jc.ErrorIsNil)
@@ -44,6 +44,14 @@ type AgentInfo struct {
 
 	// LogDir is the path to the agent's log dir.
 	LogDir string
+
+	// LogFormat is the format of the agent's log output. If it is
+	// empty, the agent uses its default text format.
+	LogFormat string
+
+	// LogLabels holds the labels recorded with each entry of the
+	// agent's structured log output.
+	LogLabels map[string]string
 }
 
 // NewAgentInfo composes a new AgentInfo for the given essentials.
@@ -344,6 +344,9 @@ func (st *State) addMachineInsideMachineOps(template MachineTemplate, parentId s
 	if !parent.supportsContainerType(containerType) {
 		return nil, nil, errors.Errorf("machine %s cannot host %s containers", parentId, containerType)
 	}
+	if err := parent.checkNotLockedForSeriesUpgrade(); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
 
 	newId, err := st.newContainerId(parentId, containerType)
 	if err != nil {
@@ -360,6 +363,8 @@ func (st *State) addMachineInsideMachineOps(template MachineTemplate, parentId s
 		st.addChildToContainerRefOp(parentId, mdoc.Id),
 		// Create a containers reference document for the container itself.
 		st.insertNewContainerRefOp(mdoc.Id),
+		// The host may not gain containers while its series is upgraded.
+		assertNoUpgradeSeriesLockOp(parent.doc.DocID),
 	)
 	return mdoc, append(prereqOps, machineOp), nil
 }
@@ -1476,7 +1476,7 @@ func (a *Application) UpdateLeaderSettings(token leadership.Token, updates map[s
 	return a.st.run(buildTxnWithLeadership(buildTxn, token))
 }
 
-var ErrSubordinateConstraints = stderrors.New("constraints do not apply to subordinate services")
+var ErrSubordinateConstraints = stderrors.New("constraints do not apply to subordinate applications")
 
 // Constraints returns the current application constraints.
 func (a *Application) Constraints() (constraints.Value, error) {
@@ -1923,6 +1923,7 @@ func (s *ApplicationSuite) TestSubordinateConstraints(c *gc.C) {
 
 	err = logging.SetConstraints(constraints.Value{})
 	c.Assert(err, gc.Equals, state.ErrSubordinateConstraints)
+	c.Assert(err, gc.ErrorMatches, "constraints do not apply to subordinate applications")
 }
 
 func (s *ApplicationSuite) TestWatchUnitsBulkEvents(c *gc.C) {
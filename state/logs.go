@@ -61,9 +61,13 @@ type ModelSessioner interface {
 
 // InitDbLogs sets up the indexes for the logs collection. It should
 // be called as state is opened. It is idempotent.
+//
+// Logs are always queried by model, and most often also by time,
+// entity or minimum level (see debug-log), so each index starts with
+// the model UUID.
 func InitDbLogs(session *mgo.Session) error {
 	logsColl := session.DB(logsDB).C(logsC)
-	for _, key := range [][]string{{"e", "t"}, {"e", "n"}} {
+	for _, key := range [][]string{{"e", "t"}, {"e", "n"}, {"e", "v"}} {
 		err := logsColl.EnsureIndex(mgo.Index{Key: key})
 		if err != nil {
 			return errors.Annotate(err, "cannot create index for logs collection")
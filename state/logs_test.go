@@ -161,6 +161,7 @@ func (s *LogsSuite) TestIndexesCreated(c *gc.C) {
 		"_id", // default index
 		"e-t", // model-uuid and timestamp
 		"e-n", // model-uuid and entity
+		"e-v", // model-uuid and level
 	})
 }
 
@@ -177,6 +177,93 @@ func (m *Machine) Series() string {
 	return m.doc.Series
 }
 
+// UpdateMachineSeries records that the machine's operating system has
+// been upgraded in place to the given series, updating the series of
+// the units it hosts to match. Unless force is true, it fails if the
+// charm of any of those units does not support the new series.
+//
+// The machine must have been locked for an upgrade to the series with
+// CreateUpgradeSeriesLock; the lock is released when the series is
+// updated.
+//
+// An application is moved to the new series along with its last unit
+// on the old series, so that further units of it may then be placed
+// on the machine. Until then it keeps its old series, and new units
+// are placed on machines running that series.
+func (m *Machine) UpdateMachineSeries(series string, force bool) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot update series of machine %s to %q", m, series)
+	if series == "" {
+		return errors.NotValidf("empty series")
+	}
+	// Local variable so we can refresh the machine without disrupting
+	// the caller.
+	machine := m
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if machine, err = machine.st.Machine(machine.Id()); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if machine.Life() != Alive {
+			return nil, errors.Errorf("machine is not alive")
+		}
+		if machine.doc.Series == series {
+			return nil, jujutxn.ErrNoOperations
+		}
+		lock, err := machine.upgradeSeriesLock()
+		if errors.IsNotFound(err) {
+			return nil, errors.Errorf("machine is not locked for series upgrade")
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if lock.ToSeries != series {
+			return nil, errors.Errorf("machine is locked for upgrade to series %q", lock.ToSeries)
+		}
+		units, err := machine.Units()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ops := []txn.Op{{
+			C:  machinesC,
+			Id: machine.doc.DocID,
+			Assert: bson.D{
+				{"life", Alive},
+				{"series", machine.doc.Series},
+				{"principals", machine.doc.Principals},
+			},
+			Update: bson.D{{"$set", bson.D{{"series", series}}}},
+		}, releaseUpgradeSeriesLockOp(machine.doc.DocID, series)}
+		for _, unit := range units {
+			if !force {
+				if err := unit.checkSeriesSupported(series); err != nil {
+					return nil, errors.Trace(err)
+				}
+			}
+			assert := bson.D{{"series", unit.doc.Series}}
+			if unit.doc.Principal == "" {
+				// Assert the principal's subordinates are unchanged,
+				// so that none is left on the old series.
+				assert = append(assert, bson.DocElem{"subordinates", unit.doc.Subordinates})
+			}
+			ops = append(ops, txn.Op{
+				C:      unitsC,
+				Id:     unit.doc.DocID,
+				Assert: assert,
+				Update: bson.D{{"$set", bson.D{{"series", series}}}},
+			})
+		}
+		appOps, err := applicationSeriesOps(machine.st, units, series)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return append(ops, appOps...), nil
+	}
+	if err := m.st.run(buildTxn); err != nil {
+		return errors.Trace(err)
+	}
+	return m.Refresh()
+}
+
 // ContainerType returns the type of container hosting this machine.
 func (m *Machine) ContainerType() instance.ContainerType {
 	return instance.ContainerType(m.doc.ContainerType)
@@ -669,6 +756,10 @@ func (original *Machine) advanceLifecycle(life Life) (err error) {
 		if m.doc.HasVote {
 			return nil, fmt.Errorf("machine %s is a voting replica set member", m.doc.Id)
 		}
+		if err := m.checkNotLockedForSeriesUpgrade(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		lockOp := assertNoUpgradeSeriesLockOp(m.doc.DocID)
 		// If there are no alive units left on the machine, or all the services are dying,
 		// then the machine may be soon destroyed by a cleanup worker.
 		// In that case, we don't want to return any error about not being able to
@@ -715,7 +806,7 @@ func (original *Machine) advanceLifecycle(life Life) (err error) {
 						{{"children", bson.D{{"$exists", false}}}},
 					}}},
 				}
-				return []txn.Op{op, containerCheck, cleanupOp}, nil
+				return []txn.Op{op, containerCheck, lockOp, cleanupOp}, nil
 			}
 		}
 
@@ -739,7 +830,7 @@ func (original *Machine) advanceLifecycle(life Life) (err error) {
 
 		// Add the additional asserts needed for this transaction.
 		op.Assert = advanceAsserts
-		return []txn.Op{op, cleanupOp}, nil
+		return []txn.Op{op, lockOp, cleanupOp}, nil
 	}
 	if err = m.st.run(buildTxn); err == jujutxn.ErrExcessiveContention {
 		err = errors.Annotatef(err, "machine %s cannot advance lifecycle", m)
@@ -848,6 +939,7 @@ func (m *Machine) removeOps() ([]txn.Op, error) {
 		removeMachineBlockDevicesOp(m.Id()),
 		removeModelMachineRefOp(m.st, m.Id()),
 		removeSSHHostKeyOp(m.st, m.globalKey()),
+		removeUpgradeSeriesLockOp(m.doc.DocID),
 	}
 	linkLayerDevicesOps, err := m.removeAllLinkLayerDevicesOps()
 	if err != nil {
@@ -53,6 +53,213 @@ func (s *MachineSuite) SetUpTest(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *MachineSuite) addMultiSeriesUnit(c *gc.C) (*state.Machine, *state.Unit) {
+	machine, err := s.State.AddMachine("precise", state.JobHostUnits)
+	c.Assert(err, jc.ErrorIsNil)
+	ch := state.AddTestingCharmMultiSeries(c, s.State, "multi-series")
+	app := state.AddTestingServiceForSeries(c, s.State, "precise", "multi", ch)
+	unit, err := app.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.AssignToMachine(machine)
+	c.Assert(err, jc.ErrorIsNil)
+	return machine, unit
+}
+
+func (s *MachineSuite) TestUpdateMachineSeries(c *gc.C) {
+	machine, unit := s.addMultiSeriesUnit(c)
+	err := machine.CreateUpgradeSeriesLock("trusty")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = machine.UpdateMachineSeries("trusty", false)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machine.Series(), gc.Equals, "trusty")
+
+	err = unit.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(unit.Series(), gc.Equals, "trusty")
+
+	// The lock is released once the series is updated.
+	locked, err := machine.IsLockedForSeriesUpgrade()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(locked, jc.IsFalse)
+}
+
+func (s *MachineSuite) TestUpdateMachineSeriesNoUnits(c *gc.C) {
+	err := s.machine.CreateUpgradeSeriesLock("xenial")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.machine.UpdateMachineSeries("xenial", false)
+	c.Assert(err, jc.ErrorIsNil)
+
+	machine, err := s.State.Machine(s.machine.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machine.Series(), gc.Equals, "xenial")
+}
+
+func (s *MachineSuite) TestUpdateMachineSeriesUnsupported(c *gc.C) {
+	machine, unit := s.addMultiSeriesUnit(c)
+	err := machine.CreateUpgradeSeriesLock("xenial")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = machine.UpdateMachineSeries("xenial", false)
+	c.Assert(err, gc.ErrorMatches, `cannot update series of machine 2 to "xenial": `+
+		`charm "cs:multi-series-\d+" of unit multi/0 does not support series "xenial"`)
+	c.Assert(machine.Series(), gc.Equals, "precise")
+
+	err = machine.UpdateMachineSeries("xenial", true)
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(unit.Series(), gc.Equals, "xenial")
+}
+
+func (s *MachineSuite) TestUpdateMachineSeriesInvalid(c *gc.C) {
+	err := s.machine.UpdateMachineSeries("", false)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+
+	err = s.machine.EnsureDead()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.machine.UpdateMachineSeries("xenial", false)
+	c.Assert(err, gc.ErrorMatches, `cannot update series of machine 1 to "xenial": machine is not alive`)
+}
+
+func (s *MachineSuite) TestUpdateMachineSeriesNotLocked(c *gc.C) {
+	err := s.machine.UpdateMachineSeries("xenial", false)
+	c.Assert(err, gc.ErrorMatches, `cannot update series of machine 1 to "xenial": machine is not locked for series upgrade`)
+
+	err = s.machine.CreateUpgradeSeriesLock("trusty")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.machine.UpdateMachineSeries("xenial", false)
+	c.Assert(err, gc.ErrorMatches, `cannot update series of machine 1 to "xenial": machine is locked for upgrade to series "trusty"`)
+	c.Assert(s.machine.Series(), gc.Equals, "quantal")
+}
+
+func (s *MachineSuite) TestUpdateMachineSeriesMovesApplication(c *gc.C) {
+	machine, unit := s.addMultiSeriesUnit(c)
+	err := machine.CreateUpgradeSeriesLock("trusty")
+	c.Assert(err, jc.ErrorIsNil)
+	err = machine.UpdateMachineSeries("trusty", false)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The application's only unit has moved, so the application moves
+	// too, and further units may be placed on the machine.
+	app, err := unit.Application()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(app.Series(), gc.Equals, "trusty")
+	another, err := app.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(another.Series(), gc.Equals, "trusty")
+	err = another.AssignToMachine(machine)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *MachineSuite) TestUpdateMachineSeriesMovesApplicationWithLastUnit(c *gc.C) {
+	machine1, unit := s.addMultiSeriesUnit(c)
+	app, err := unit.Application()
+	c.Assert(err, jc.ErrorIsNil)
+	machine2, err := s.State.AddMachine("precise", state.JobHostUnits)
+	c.Assert(err, jc.ErrorIsNil)
+	unit2, err := app.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit2.AssignToMachine(machine2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// While another unit is on the old series, the application
+	// stays on it.
+	err = machine1.CreateUpgradeSeriesLock("trusty")
+	c.Assert(err, jc.ErrorIsNil)
+	err = machine1.UpdateMachineSeries("trusty", false)
+	c.Assert(err, jc.ErrorIsNil)
+	err = app.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(app.Series(), gc.Equals, "precise")
+
+	err = machine2.CreateUpgradeSeriesLock("trusty")
+	c.Assert(err, jc.ErrorIsNil)
+	err = machine2.UpdateMachineSeries("trusty", false)
+	c.Assert(err, jc.ErrorIsNil)
+	err = app.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(app.Series(), gc.Equals, "trusty")
+}
+
+func (s *MachineSuite) TestUpgradeSeriesLockPreventsAssignment(c *gc.C) {
+	machine, unit := s.addMultiSeriesUnit(c)
+	err := machine.CreateUpgradeSeriesLock("trusty")
+	c.Assert(err, jc.ErrorIsNil)
+
+	app, err := unit.Application()
+	c.Assert(err, jc.ErrorIsNil)
+	another, err := app.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = another.AssignToMachine(machine)
+	c.Assert(err, gc.ErrorMatches, `cannot assign unit "multi/1" to machine 2: machine 2 is locked for series upgrade`)
+}
+
+func (s *MachineSuite) TestUpgradeSeriesLockPreventsContainers(c *gc.C) {
+	err := s.machine.CreateUpgradeSeriesLock("xenial")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.State.AddMachineInsideMachine(state.MachineTemplate{
+		Series: "quantal",
+		Jobs:   []state.MachineJob{state.JobHostUnits},
+	}, s.machine.Id(), instance.LXD)
+	c.Assert(err, gc.ErrorMatches, `cannot add a new machine: machine 1 is locked for series upgrade`)
+}
+
+func (s *MachineSuite) TestUpgradeSeriesLockPreventsDestroy(c *gc.C) {
+	err := s.machine.CreateUpgradeSeriesLock("xenial")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.machine.Destroy()
+	c.Assert(err, gc.ErrorMatches, `machine 1 is locked for series upgrade`)
+	err = s.machine.EnsureDead()
+	c.Assert(err, gc.ErrorMatches, `machine 1 is locked for series upgrade`)
+
+	err = s.machine.RemoveUpgradeSeriesLock()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.machine.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *MachineSuite) TestCreateUpgradeSeriesLock(c *gc.C) {
+	locked, err := s.machine.IsLockedForSeriesUpgrade()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(locked, jc.IsFalse)
+
+	err = s.machine.CreateUpgradeSeriesLock("xenial")
+	c.Assert(err, jc.ErrorIsNil)
+	locked, err = s.machine.IsLockedForSeriesUpgrade()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(locked, jc.IsTrue)
+
+	err = s.machine.CreateUpgradeSeriesLock("xenial")
+	c.Assert(err, jc.Satisfies, errors.IsAlreadyExists)
+	c.Assert(err, gc.ErrorMatches, `cannot lock machine 1 for series upgrade: series upgrade lock already exists`)
+
+	err = s.machine.RemoveUpgradeSeriesLock()
+	c.Assert(err, jc.ErrorIsNil)
+	locked, err = s.machine.IsLockedForSeriesUpgrade()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(locked, jc.IsFalse)
+
+	// Removing a lock that is not held is not an error.
+	err = s.machine.RemoveUpgradeSeriesLock()
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *MachineSuite) TestCreateUpgradeSeriesLockInvalid(c *gc.C) {
+	err := s.machine.CreateUpgradeSeriesLock("")
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+
+	err = s.machine.CreateUpgradeSeriesLock("quantal")
+	c.Assert(err, gc.ErrorMatches, `cannot lock machine 1 for series upgrade: machine is already running series "quantal"`)
+
+	err = s.machine.EnsureDead()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.machine.CreateUpgradeSeriesLock("xenial")
+	c.Assert(err, gc.ErrorMatches, `cannot lock machine 1 for series upgrade: machine is not alive`)
+}
+
 func (s *MachineSuite) TestSetRebootFlagDeadMachine(c *gc.C) {
 	err := s.machine.EnsureDead()
 	c.Assert(err, jc.ErrorIsNil)
@@ -164,6 +164,7 @@ func (s *MigrationSuite) TestKnownCollections(c *gc.C) {
 
 		// machine
 		rebootC,
+		upgradeSeriesLocksC,
 
 		// service / unit
 		charmsC,
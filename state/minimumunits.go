@@ -127,7 +127,8 @@ func (s *Application) MinUnits() int {
 }
 
 // EnsureMinUnits adds new units if the service's MinUnits value is greater
-// than the number of alive units.
+// than the number of alive units. New units are placed on existing clean,
+// empty machines where possible, and on new machines otherwise.
 func (s *Application) EnsureMinUnits() (err error) {
 	defer errors.DeferredAnnotatef(&err, "cannot ensure minimum units for application %q", s)
 	service := &Application{st: s.st, doc: s.doc}
@@ -162,7 +163,7 @@ func (s *Application) EnsureMinUnits() (err error) {
 			if err != nil {
 				return err
 			}
-			if err := service.st.AssignUnit(unit, AssignNew); err != nil {
+			if err := service.st.AssignUnit(unit, AssignCleanEmpty); err != nil {
 				return err
 			}
 			// No need to proceed and refresh the service if this was the
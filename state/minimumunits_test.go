@@ -318,6 +318,22 @@ func (s *MinUnitsSuite) TestEnsureMinUnits(c *gc.C) {
 	}
 }
 
+func (s *MinUnitsSuite) TestEnsureMinUnitsPrefersCleanEmptyMachine(c *gc.C) {
+	machine, err := s.State.AddMachine("quantal", state.JobHostUnits)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.service.SetMinUnits(1)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.service.EnsureMinUnits()
+	c.Assert(err, jc.ErrorIsNil)
+	units, err := s.service.AllUnits()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(units, gc.HasLen, 1)
+	machineId, err := units[0].AssignedMachineId()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machineId, gc.Equals, machine.Id())
+}
+
 func (s *MinUnitsSuite) TestEnsureMinUnitsServiceNotAlive(c *gc.C) {
 	err := s.service.SetMinUnits(2)
 	c.Assert(err, jc.ErrorIsNil)
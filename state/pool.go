@@ -125,6 +125,14 @@ func (p *StatePool) SystemState() *State {
 	return p.systemState
 }
 
+// Size returns the number of State instances in the pool, not
+// counting the system State.
+func (p *StatePool) Size() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.pool)
+}
+
 // KillWorkers tells the internal worker for all cached State
 // instances in the pool to die.
 func (p *StatePool) KillWorkers() {
@@ -72,6 +72,20 @@ func (s *statePoolSuite) TestGetSystemState(c *gc.C) {
 	c.Assert(st0, gc.Equals, s.State)
 }
 
+func (s *statePoolSuite) TestSize(c *gc.C) {
+	c.Assert(s.Pool.Size(), gc.Equals, 0)
+
+	_, err := s.Pool.Get(s.ModelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.Pool.Size(), gc.Equals, 0)
+
+	_, err = s.Pool.Get(s.ModelUUID1)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.Pool.Get(s.ModelUUID2)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.Pool.Size(), gc.Equals, 2)
+}
+
 func (s *statePoolSuite) TestKillWorkers(c *gc.C) {
 	// Get some State instances via the pool and extract their
 	// internal workers.
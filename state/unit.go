@@ -145,6 +145,30 @@ func (u *Unit) Series() string {
 	return u.doc.Series
 }
 
+// checkSeriesSupported returns an error if the unit's charm does not
+// support the given series.
+func (u *Unit) checkSeriesSupported(series string) error {
+	application, err := u.Application()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ch, _, err := application.Charm()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// Old style charms are written for the single series in their URL.
+	supported := ch.Meta().Series
+	if urlSeries := ch.URL().Series; urlSeries != "" {
+		supported = []string{urlSeries}
+	}
+	for _, s := range supported {
+		if s == series {
+			return nil
+		}
+	}
+	return errors.Errorf("charm %q of unit %s does not support series %q", ch.URL(), u.Name(), series)
+}
+
 // String returns the unit as string.
 func (u *Unit) String() string {
 	return u.doc.Name
@@ -542,7 +566,7 @@ func (u *Unit) removeOps(asserts bson.D) ([]txn.Op, error) {
 
 // ErrUnitHasSubordinates is a standard error to indicate that a Unit
 // cannot complete an operation to end its life because it still has
-// subordinate services
+// subordinate applications
 var ErrUnitHasSubordinates = errors.New("unit has subordinates")
 
 var unitHasNoSubordinates = bson.D{{
@@ -667,7 +691,7 @@ func (u *Unit) Resolved() ResolvedMode {
 }
 
 // IsPrincipal returns whether the unit is deployed in its own container,
-// and can therefore have subordinate services deployed alongside it.
+// and can therefore have subordinate applications deployed alongside it.
 func (u *Unit) IsPrincipal() bool {
 	return u.doc.Principal == ""
 }
@@ -1333,6 +1357,7 @@ func (u *Unit) assignToMachineOps(m *Machine, unused bool) ([]txn.Op, error) {
 		Update: bson.D{{"$addToSet", bson.D{{"principals", u.doc.Name}}}, {"$set", bson.D{{"clean", false}}}},
 	},
 		removeStagedAssignmentOp(u.doc.DocID),
+		assertNoUpgradeSeriesLockOp(m.doc.DocID),
 	}
 	ops = append(ops, storageOps...)
 	return ops, nil
@@ -1365,6 +1390,9 @@ func validateUnitMachineAssignment(
 	if !canHost {
 		return fmt.Errorf("machine %q cannot host units", m)
 	}
+	if err := m.checkNotLockedForSeriesUpgrade(); err != nil {
+		return errors.Trace(err)
+	}
 	if err := validateDynamicMachineStoragePools(m, storagePools); err != nil {
 		return errors.Trace(err)
 	}
@@ -0,0 +1,203 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/utils/set"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/mgo.v2/txn"
+)
+
+// upgradeSeriesLockDoc records that a machine is being prepared for an
+// in-place upgrade of its operating system series. While the lock is
+// held no other series upgrade of the machine may be started, no units
+// may be assigned to it, no containers added to it, and it may not be
+// destroyed.
+type upgradeSeriesLockDoc struct {
+	DocID     string `bson:"_id"`
+	Id        string `bson:"machineid"`
+	ModelUUID string `bson:"model-uuid"`
+	ToSeries  string `bson:"to-series"`
+}
+
+// CreateUpgradeSeriesLock locks the machine for an upgrade to the given
+// series. It fails if the machine is not alive, already has the series,
+// or is already locked for a series upgrade.
+func (m *Machine) CreateUpgradeSeriesLock(series string) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot lock machine %s for series upgrade", m)
+	if series == "" {
+		return errors.NotValidf("empty series")
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := m.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if m.Life() != Alive {
+			return nil, errors.Errorf("machine is not alive")
+		}
+		if m.doc.Series == series {
+			return nil, errors.Errorf("machine is already running series %q", series)
+		}
+		locked, err := m.IsLockedForSeriesUpgrade()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if locked {
+			return nil, errors.AlreadyExistsf("series upgrade lock")
+		}
+		return []txn.Op{{
+			C:      machinesC,
+			Id:     m.doc.DocID,
+			Assert: isAliveDoc,
+		}, {
+			C:      upgradeSeriesLocksC,
+			Id:     m.doc.DocID,
+			Assert: txn.DocMissing,
+			Insert: &upgradeSeriesLockDoc{
+				Id:       m.Id(),
+				ToSeries: series,
+			},
+		}}, nil
+	}
+	return m.st.run(buildTxn)
+}
+
+// IsLockedForSeriesUpgrade returns whether the machine is locked for
+// an upgrade of its series.
+func (m *Machine) IsLockedForSeriesUpgrade() (bool, error) {
+	_, err := m.upgradeSeriesLock()
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// RemoveUpgradeSeriesLock removes the machine's series upgrade lock,
+// abandoning the upgrade. It does nothing if the machine is not locked.
+func (m *Machine) RemoveUpgradeSeriesLock() error {
+	err := m.st.runTransaction([]txn.Op{removeUpgradeSeriesLockOp(m.doc.DocID)})
+	return errors.Annotatef(err, "cannot remove series upgrade lock of machine %s", m)
+}
+
+func (m *Machine) upgradeSeriesLock() (*upgradeSeriesLockDoc, error) {
+	locks, closer := m.st.getCollection(upgradeSeriesLocksC)
+	defer closer()
+
+	var doc upgradeSeriesLockDoc
+	err := locks.FindId(m.doc.DocID).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("series upgrade lock for machine %s", m)
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get series upgrade lock for machine %s", m)
+	}
+	return &doc, nil
+}
+
+// applicationSeriesOps returns the operations that move the
+// applications of the given units, which are being moved to the given
+// series, to that series too if all their other units already run it.
+// The operations assert that the other units' series and the number of
+// units are unchanged.
+func applicationSeriesOps(st *State, moving []*Unit, series string) ([]txn.Op, error) {
+	movingNames := set.NewStrings()
+	appNames := set.NewStrings()
+	for _, unit := range moving {
+		movingNames.Add(unit.Name())
+		appNames.Add(unit.ApplicationName())
+	}
+	var ops []txn.Op
+	for _, appName := range appNames.SortedValues() {
+		app, err := st.Application(appName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if app.doc.Series == series {
+			continue
+		}
+		units, err := app.AllUnits()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		var unitOps []txn.Op
+		allMoved := true
+		for _, unit := range units {
+			if movingNames.Contains(unit.Name()) {
+				continue
+			}
+			if unit.doc.Series != series {
+				allMoved = false
+				break
+			}
+			unitOps = append(unitOps, txn.Op{
+				C:      unitsC,
+				Id:     unit.doc.DocID,
+				Assert: bson.D{{"series", series}},
+			})
+		}
+		if !allMoved {
+			continue
+		}
+		ops = append(ops, unitOps...)
+		ops = append(ops, txn.Op{
+			C:  applicationsC,
+			Id: app.doc.DocID,
+			Assert: bson.D{
+				{"series", app.doc.Series},
+				{"unitcount", app.doc.UnitCount},
+			},
+			Update: bson.D{{"$set", bson.D{{"series", series}}}},
+		})
+	}
+	return ops, nil
+}
+
+// checkNotLockedForSeriesUpgrade returns an error if the machine is
+// locked for a series upgrade, and so may not be changed.
+func (m *Machine) checkNotLockedForSeriesUpgrade() error {
+	locked, err := m.IsLockedForSeriesUpgrade()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if locked {
+		return errors.Errorf("machine %s is locked for series upgrade", m)
+	}
+	return nil
+}
+
+// assertNoUpgradeSeriesLockOp asserts that the machine with the given
+// document id is not locked for a series upgrade.
+func assertNoUpgradeSeriesLockOp(docID string) txn.Op {
+	return txn.Op{
+		C:      upgradeSeriesLocksC,
+		Id:     docID,
+		Assert: txn.DocMissing,
+	}
+}
+
+// releaseUpgradeSeriesLockOp removes the machine's series upgrade lock,
+// asserting that it was taken for an upgrade to the given series.
+func releaseUpgradeSeriesLockOp(docID, series string) txn.Op {
+	return txn.Op{
+		C:      upgradeSeriesLocksC,
+		Id:     docID,
+		Assert: bson.D{{"to-series", series}},
+		Remove: true,
+	}
+}
+
+func removeUpgradeSeriesLockOp(docID string) txn.Op {
+	return txn.Op{
+		C:      upgradeSeriesLocksC,
+		Id:     docID,
+		Remove: true,
+	}
+}
@@ -773,6 +773,53 @@ func (st *State) newVolumeOps(doc volumeDoc, status statusDoc) []txn.Op {
 	}
 }
 
+// AddExistingVolume adds to the model a volume that was provisioned
+// outside of Juju, recording the given info for it. The volume is
+// model-scoped and not bound to any other entity, so it will not be
+// destroyed unless it is explicitly removed.
+func (st *State) AddExistingVolume(info VolumeInfo) (_ names.VolumeTag, err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot add existing volume %q", info.VolumeId)
+	if info.VolumeId == "" {
+		return names.VolumeTag{}, errors.New("volume ID not set")
+	}
+	if err := validateStoragePool(st, info.Pool, storage.StorageKindBlock, nil); err != nil {
+		return names.VolumeTag{}, errors.Trace(err)
+	}
+	providerType, provider, err := poolStorageProvider(st, info.Pool)
+	if err != nil {
+		return names.VolumeTag{}, errors.Trace(err)
+	}
+	if provider.Scope() == storage.ScopeMachine {
+		return names.VolumeTag{}, errors.NotSupportedf("importing volumes from machine-scoped %q provider", providerType)
+	}
+	existing, err := st.volumes(bson.D{
+		{"info.pool", info.Pool},
+		{"info.volumeid", info.VolumeId},
+	})
+	if err != nil {
+		return names.VolumeTag{}, errors.Trace(err)
+	}
+	if len(existing) > 0 {
+		return names.VolumeTag{}, errors.AlreadyExistsf("volume %q in pool %q", info.VolumeId, info.Pool)
+	}
+	name, err := newVolumeName(st, "")
+	if err != nil {
+		return names.VolumeTag{}, errors.Annotate(err, "cannot generate volume name")
+	}
+	status := statusDoc{
+		Status:  status.Detached,
+		Updated: st.clock.Now().UnixNano(),
+	}
+	doc := volumeDoc{
+		Name: name,
+		Info: &info,
+	}
+	if err := st.runTransaction(st.newVolumeOps(doc, status)); err != nil {
+		return names.VolumeTag{}, errors.Trace(err)
+	}
+	return names.NewVolumeTag(name), nil
+}
+
 func (st *State) volumeParamsWithDefaults(params VolumeParams) (VolumeParams, error) {
 	if params.Pool != "" {
 		return params, nil
@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/provider/dummy"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/testing"
+	"github.com/juju/juju/status"
 	"github.com/juju/juju/storage/poolmanager"
 	"github.com/juju/juju/storage/provider"
 )
@@ -237,6 +238,48 @@ func (s *VolumeStateSuite) TestSetVolumeInfoImmutable(c *gc.C) {
 	s.assertVolumeInfo(c, volumeTag, volumeInfoSet)
 }
 
+func (s *VolumeStateSuite) TestAddExistingVolume(c *gc.C) {
+	info := state.VolumeInfo{
+		Pool:       "persistent-block",
+		Size:       123,
+		VolumeId:   "vol-ume",
+		Persistent: true,
+	}
+	volumeTag, err := s.State.AddExistingVolume(info)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(volumeTag, gc.Equals, names.NewVolumeTag("0"))
+	s.assertVolumeInfo(c, volumeTag, info)
+
+	volume := s.volume(c, volumeTag)
+	c.Assert(volume.LifeBinding(), gc.IsNil)
+	_, err = volume.StorageInstance()
+	c.Assert(err, jc.Satisfies, errors.IsNotAssigned)
+	volumeStatus, err := volume.Status()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(volumeStatus.Status, gc.Equals, status.Detached)
+}
+
+func (s *VolumeStateSuite) TestAddExistingVolumeTwice(c *gc.C) {
+	info := state.VolumeInfo{Pool: "persistent-block", Size: 123, VolumeId: "vol-ume"}
+	_, err := s.State.AddExistingVolume(info)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.AddExistingVolume(info)
+	c.Assert(err, gc.ErrorMatches, `cannot add existing volume "vol-ume": volume "vol-ume" in pool "persistent-block" already exists`)
+	c.Assert(err, jc.Satisfies, errors.IsAlreadyExists)
+}
+
+func (s *VolumeStateSuite) TestAddExistingVolumeNoVolumeId(c *gc.C) {
+	_, err := s.State.AddExistingVolume(state.VolumeInfo{Pool: "persistent-block", Size: 123})
+	c.Assert(err, gc.ErrorMatches, `cannot add existing volume "": volume ID not set`)
+}
+
+func (s *VolumeStateSuite) TestAddExistingVolumeMachineScopedPool(c *gc.C) {
+	info := state.VolumeInfo{Pool: "loop-pool", Size: 123, VolumeId: "vol-ume"}
+	_, err := s.State.AddExistingVolume(info)
+	c.Assert(err, gc.ErrorMatches, `cannot add existing volume "vol-ume": importing volumes from machine-scoped "loop" provider not supported`)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
 func (s *VolumeStateSuite) TestWatchVolumeAttachment(c *gc.C) {
 	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
 	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
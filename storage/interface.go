@@ -127,6 +127,20 @@ type VolumeSource interface {
 	DetachVolumes(params []VolumeAttachmentParams) ([]error, error)
 }
 
+// VolumeImporter provides an interface for importing volumes
+// into the controller/model. A VolumeSource may optionally implement
+// VolumeImporter.
+type VolumeImporter interface {
+	// ImportVolume updates the volume with the specified volume
+	// provider ID with the given resource tags, so that it is seen
+	// as being managed by this Juju controller/model. ImportVolume
+	// returns the volume information to store in the model.
+	//
+	// Implementations of ImportVolume should validate that the
+	// volume is not in use before allowing the import to proceed.
+	ImportVolume(volumeId string, resourceTags map[string]string) (VolumeInfo, error)
+}
+
 // FilesystemSource provides an interface for creating, destroying and
 // describing filesystems in the environment. A FilesystemSource is
 // configured in a particular way, and corresponds to a storage "pool".
@@ -112,10 +112,17 @@ func (pm *poolManager) configFromSettings(settings map[string]interface{}) (*sto
 	providerType := storage.ProviderType(settings[Type].(string))
 	name := settings[Name].(string)
 	// Ensure returned attributes are stripped of name and type,
-	// as these are not user-specified attributes.
-	delete(settings, Name)
-	delete(settings, Type)
-	cfg, err := storage.NewConfig(name, providerType, settings)
+	// as these are not user-specified attributes. The settings
+	// are copied first, so that the caller's map (which may be
+	// the backing store itself) is left untouched.
+	attrs := make(map[string]interface{}, len(settings))
+	for k, v := range settings {
+		if k == Name || k == Type {
+			continue
+		}
+		attrs[k] = v
+	}
+	cfg, err := storage.NewConfig(name, providerType, attrs)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -92,6 +92,22 @@ func (s *poolSuite) TestPool(c *gc.C) {
 	c.Assert(p.Provider(), gc.Equals, storage.ProviderType("loop"))
 }
 
+func (s *poolSuite) TestPoolRepeatedGetMemSettings(c *gc.C) {
+	settings := poolmanager.MemSettings{make(map[string]map[string]interface{})}
+	pm := poolmanager.New(settings, s.registry)
+	_, err := pm.Create("testpool", storage.ProviderType("loop"), map[string]interface{}{"foo": "bar"})
+	c.Assert(err, jc.ErrorIsNil)
+	for i := 0; i < 2; i++ {
+		p, err := pm.Get("testpool")
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(p.Attrs(), gc.DeepEquals, map[string]interface{}{"foo": "bar"})
+		c.Assert(p.Name(), gc.Equals, "testpool")
+	}
+	c.Assert(settings.Settings["pool#testpool"], jc.DeepEquals, map[string]interface{}{
+		"name": "testpool", "type": "loop", "foo": "bar",
+	})
+}
+
 func (s *poolSuite) TestCreate(c *gc.C) {
 	created, err := s.poolManager.Create("testpool", storage.ProviderType("loop"), map[string]interface{}{"foo": "bar"})
 	c.Assert(err, jc.ErrorIsNil)
@@ -150,10 +150,25 @@ func (s *tmpfsFilesystemSource) createFilesystem(params storage.FilesystemParams
 
 // DestroyFilesystems is defined on the FilesystemSource interface.
 func (s *tmpfsFilesystemSource) DestroyFilesystems(filesystemIds []string) ([]error, error) {
-	// DestroyFilesystems is a no-op; there is nothing to destroy,
-	// since the filesystem is ephemeral and disappears once
-	// detached.
-	return make([]error, len(filesystemIds)), nil
+	// There is nothing to destroy on the host, since the filesystem
+	// is ephemeral and disappears once detached; we need only remove
+	// the filesystem info written by CreateFilesystems.
+	results := make([]error, len(filesystemIds))
+	for i, id := range filesystemIds {
+		results[i] = s.destroyFilesystem(id)
+	}
+	return results, nil
+}
+
+func (s *tmpfsFilesystemSource) destroyFilesystem(filesystemId string) error {
+	tag, err := names.ParseFilesystemTag(filesystemId)
+	if err != nil {
+		return errors.Annotatef(err, "invalid filesystem ID %q", filesystemId)
+	}
+	if err := os.Remove(s.filesystemInfoFile(tag)); err != nil && !os.IsNotExist(err) {
+		return errors.Annotate(err, "removing filesystem info")
+	}
+	return nil
 }
 
 // AttachFilesystems is defined on the FilesystemSource interface.
@@ -106,6 +106,35 @@ func (s *tmpfsSuite) TestCreateFilesystems(c *gc.C) {
 	}})
 }
 
+func (s *tmpfsSuite) TestDestroyFilesystemsRemovesInfo(c *gc.C) {
+	source := s.tmpfsFilesystemSource(c)
+	params := []storage.FilesystemParams{{
+		Tag:  names.NewFilesystemTag("6"),
+		Size: 2,
+	}}
+
+	results, err := source.CreateFilesystems(params)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+
+	errs, err := source.DestroyFilesystems([]string{"filesystem-6", "filesystem-7"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs, jc.DeepEquals, []error{nil, nil})
+
+	// The filesystem info is gone, so the same tag may be reused.
+	results, err = source.CreateFilesystems(params)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+}
+
+func (s *tmpfsSuite) TestDestroyFilesystemsInvalidId(c *gc.C) {
+	source := s.tmpfsFilesystemSource(c)
+	errs, err := source.DestroyFilesystems([]string{"volume-0"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs, gc.HasLen, 1)
+	c.Assert(errs[0], gc.ErrorMatches, `invalid filesystem ID "volume-0": .*`)
+}
+
 func (s *tmpfsSuite) TestCreateFilesystemsHugePages(c *gc.C) {
 	source := s.tmpfsFilesystemSource(c)
 
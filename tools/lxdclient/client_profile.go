@@ -6,11 +6,17 @@
 package lxdclient
 
 import (
+	"sort"
+
 	"github.com/juju/errors"
 	"github.com/lxc/lxd"
 	"github.com/lxc/lxd/shared"
+
+	"github.com/juju/juju/core/lxdprofile"
 )
 
+var _ lxdprofile.ProfileManager = (*Client)(nil)
+
 type rawProfileClient interface {
 	ProfileCreate(name string) error
 	ListProfiles() ([]shared.ProfileConfig, error)
@@ -45,6 +51,23 @@ func (p profileClient) ProfileDeviceAdd(profile, devname, devtype string, props
 	return resp, err
 }
 
+// AddProfileDevice adds the named device to the given profile. The
+// device's "type" entry gives the device type, and its other entries
+// the device properties. No check is made to verify the profile exists.
+func (p profileClient) AddProfileDevice(profile, name string, device map[string]string) error {
+	var props []string
+	for key, value := range device {
+		if key != "type" {
+			props = append(props, key+"="+value)
+		}
+	}
+	sort.Strings(props)
+	if _, err := p.raw.ProfileDeviceAdd(profile, name, device["type"], props); err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
 // CreateProfile attempts to create a new lxc profile and set the given config.
 func (p profileClient) CreateProfile(name string, config map[string]string) error {
 	if err := p.raw.ProfileCreate(name); err != nil {
@@ -0,0 +1,134 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package upgrades
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/version"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/stateenvirons"
+)
+
+// environUpgradeVersions returns the target versions of the environ
+// upgrade operations of every registered provider.
+var environUpgradeVersions = func() []version.Number {
+	var versions []version.Number
+	for _, providerType := range environs.RegisteredProviders() {
+		provider, err := environs.Provider(providerType)
+		if err != nil {
+			continue
+		}
+		if versioner, ok := provider.(environs.UpgradeVersioner); ok {
+			versions = append(versions, versioner.UpgradeTargetVersions()...)
+		}
+	}
+	return versions
+}
+
+// modelEnvirons returns an Environ for each model in the controller.
+// Models whose Environ cannot be opened are logged and skipped, so that
+// one broken model does not hold up the upgrade of the controller.
+var modelEnvirons = func(st *state.State) ([]environs.Environ, error) {
+	models, err := st.AllModels()
+	if err != nil {
+		return nil, errors.Annotate(err, "listing models")
+	}
+	var envs []environs.Environ
+	for _, model := range models {
+		env, err := modelEnviron(st, model.ModelTag())
+		if err != nil {
+			logger.Warningf("skipping environ upgrade steps for model %q: %v", model.Name(), err)
+			continue
+		}
+		envs = append(envs, env)
+	}
+	return envs, nil
+}
+
+// modelEnviron returns an Environ for the model with the given tag.
+var modelEnviron = func(st *state.State, modelTag names.ModelTag) (environs.Environ, error) {
+	modelSt, err := st.ForModel(modelTag)
+	if err != nil {
+		return nil, errors.Annotate(err, "opening state")
+	}
+	defer modelSt.Close()
+	env, err := stateenvirons.GetNewEnvironFunc(environs.New)(modelSt)
+	if err != nil {
+		return nil, errors.Annotate(err, "opening environ")
+	}
+	return env, nil
+}
+
+// upgradeEnvirons runs the upgrade operations of every model's Environ
+// that implements environs.Upgrader, for versions after from and up to
+// and including to. As these operations change shared cloud resources,
+// they are run only once, by the database master.
+func upgradeEnvirons(st *state.State, from, to version.Number) error {
+	envs, err := modelEnvirons(st)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(envs) == 0 {
+		return nil
+	}
+	return runEnvironUpgradeSteps(envs, st.ControllerUUID(), from, to)
+}
+
+// runEnvironUpgradeSteps runs the relevant upgrade steps for each of
+// the given environs.
+func runEnvironUpgradeSteps(envs []environs.Environ, controllerUUID string, from, to version.Number) error {
+	for _, env := range envs {
+		upgrader, ok := env.(environs.Upgrader)
+		if !ok {
+			logger.Debugf("provider %q has no upgrades", env.Config().Type())
+			continue
+		}
+		var ops []Operation
+		for _, op := range upgrader.UpgradeOperations(environs.UpgradeOperationsParams{
+			ControllerUUID: controllerUUID,
+		}) {
+			ops = append(ops, environUpgradeOperation{op})
+		}
+		modelName := env.Config().Name()
+		if err := runUpgradeSteps(
+			newOpsIterator(from, to, ops),
+			[]Target{DatabaseMaster},
+			nil, // environ upgrade steps do not use the Context
+		); err != nil {
+			return errors.Annotatef(err, "upgrading environ for model %q", modelName)
+		}
+	}
+	return nil
+}
+
+// environUpgradeOperation adapts an environs.UpgradeOperation to
+// the Operation interface.
+type environUpgradeOperation struct {
+	op environs.UpgradeOperation
+}
+
+// TargetVersion is defined on the Operation interface.
+func (o environUpgradeOperation) TargetVersion() version.Number {
+	return o.op.TargetVersion
+}
+
+// Steps is defined on the Operation interface.
+func (o environUpgradeOperation) Steps() []Step {
+	steps := make([]Step, len(o.op.Steps))
+	for i, step := range o.op.Steps {
+		steps[i] = &upgradeStep{
+			description: step.Description(),
+			targets:     []Target{DatabaseMaster},
+			run: func(step environs.UpgradeStep) func(Context) error {
+				return func(Context) error {
+					return step.Run()
+				}
+			}(step),
+		}
+	}
+	return steps
+}
@@ -0,0 +1,162 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package upgrades_test
+
+import (
+	"errors"
+
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/state"
+	statetesting "github.com/juju/juju/state/testing"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/upgrades"
+	jujuversion "github.com/juju/juju/version"
+)
+
+type environsSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&environsSuite{})
+
+func (s *environsSuite) TestRunEnvironUpgradeSteps(c *gc.C) {
+	var ran []string
+	env := newUpgraderEnviron(c, "1.25.0", "2.0.0", "2.1.0", &ran)
+	err := upgrades.RunEnvironUpgradeSteps(
+		[]environs.Environ{env}, coretesting.ControllerTag.Id(),
+		version.MustParse("1.25.0"), version.MustParse("2.0.1"),
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ran, jc.DeepEquals, []string{"step 2.0.0"})
+	c.Assert(env.params, jc.DeepEquals, environs.UpgradeOperationsParams{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+	})
+}
+
+func (s *environsSuite) TestRunEnvironUpgradeStepsSkipsNonUpgraders(c *gc.C) {
+	env := &mockEnviron{cfg: coretesting.ModelConfig(c)}
+	err := upgrades.RunEnvironUpgradeSteps(
+		[]environs.Environ{env}, coretesting.ControllerTag.Id(),
+		version.MustParse("1.25.0"), version.MustParse("2.0.1"),
+	)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *environsSuite) TestRunEnvironUpgradeStepsError(c *gc.C) {
+	var ran []string
+	env := newUpgraderEnviron(c, "2.0.0", "2.0.1", "", &ran)
+	env.err = errors.New("boom")
+	err := upgrades.RunEnvironUpgradeSteps(
+		[]environs.Environ{env}, coretesting.ControllerTag.Id(),
+		version.MustParse("1.25.0"), version.MustParse("2.0.1"),
+	)
+	c.Assert(err, gc.ErrorMatches, `upgrading environ for model "testenv": .*boom`)
+	c.Assert(ran, jc.DeepEquals, []string{"step 2.0.0"})
+}
+
+func (s *environsSuite) TestPerformUpgradeRunsEnvironSteps(c *gc.C) {
+	var ran []string
+	env := newUpgraderEnviron(c, "2.0.0", "", "", &ran)
+	s.PatchValue(upgrades.ModelEnvirons, func(*state.State) ([]environs.Environ, error) {
+		return []environs.Environ{env}, nil
+	})
+	s.PatchValue(upgrades.StateUpgradeOperations, func() []upgrades.Operation { return nil })
+	s.PatchValue(upgrades.UpgradeOperations, func() []upgrades.Operation { return nil })
+	s.PatchValue(&jujuversion.Current, version.MustParse("2.0.1"))
+
+	ctx := &mockContext{state: new(state.State)}
+	err := upgrades.PerformUpgrade(version.MustParse("1.25.0"), targets(upgrades.DatabaseMaster), ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ran, jc.DeepEquals, []string{"step 2.0.0"})
+
+	// Environ upgrade steps are only run by the database master.
+	ran = nil
+	err = upgrades.PerformUpgrade(version.MustParse("1.25.0"), targets(upgrades.Controller), ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ran, gc.HasLen, 0)
+}
+
+type environsStateSuite struct {
+	statetesting.StateSuite
+}
+
+var _ = gc.Suite(&environsStateSuite{})
+
+func (s *environsStateSuite) TestModelEnvironsSkipsBrokenModels(c *gc.C) {
+	other := s.Factory.MakeModel(c, nil)
+	defer other.Close()
+	env := &mockEnviron{cfg: coretesting.ModelConfig(c)}
+	s.PatchValue(upgrades.ModelEnviron, func(_ *state.State, tag names.ModelTag) (environs.Environ, error) {
+		if tag == other.ModelTag() {
+			return nil, errors.New("no credentials")
+		}
+		return env, nil
+	})
+
+	envs, err := (*upgrades.ModelEnvirons)(s.State)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(envs, jc.DeepEquals, []environs.Environ{env})
+}
+
+type mockEnviron struct {
+	environs.Environ
+	cfg *config.Config
+}
+
+func (e *mockEnviron) Config() *config.Config {
+	return e.cfg
+}
+
+type mockUpgraderEnviron struct {
+	mockEnviron
+	versions []version.Number
+	ran      *[]string
+	params   environs.UpgradeOperationsParams
+	err      error
+}
+
+func newUpgraderEnviron(c *gc.C, v1, v2, v3 string, ran *[]string) *mockUpgraderEnviron {
+	env := &mockUpgraderEnviron{
+		mockEnviron: mockEnviron{cfg: coretesting.ModelConfig(c)},
+		ran:         ran,
+	}
+	for _, v := range []string{v1, v2, v3} {
+		if v != "" {
+			env.versions = append(env.versions, version.MustParse(v))
+		}
+	}
+	return env
+}
+
+func (e *mockUpgraderEnviron) UpgradeOperations(args environs.UpgradeOperationsParams) []environs.UpgradeOperation {
+	e.params = args
+	var ops []environs.UpgradeOperation
+	for _, v := range e.versions {
+		ops = append(ops, environs.UpgradeOperation{
+			TargetVersion: v,
+			Steps:         []environs.UpgradeStep{mockEnvironStep{e, "step " + v.String()}},
+		})
+	}
+	return ops
+}
+
+type mockEnvironStep struct {
+	env         *mockUpgraderEnviron
+	description string
+}
+
+func (s mockEnvironStep) Description() string {
+	return s.description
+}
+
+func (s mockEnvironStep) Run() error {
+	*s.env.ran = append(*s.env.ran, s.description)
+	return s.env.err
+}
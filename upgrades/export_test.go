@@ -3,11 +3,18 @@
 
 package upgrades
 
-import "github.com/juju/juju/environs"
+import (
+	"github.com/juju/version"
+
+	"github.com/juju/juju/environs"
+)
 
 var (
 	UpgradeOperations      = &upgradeOperations
 	StateUpgradeOperations = &stateUpgradeOperations
+	ModelEnvirons          = &modelEnvirons
+	ModelEnviron           = &modelEnviron
+	EnvironUpgradeVersions = &environUpgradeVersions
 )
 
 type ModelConfigUpdater environConfigUpdater
@@ -20,3 +27,7 @@ func UpgradeModelConfig(
 ) error {
 	return upgradeModelConfig(reader, updater, registry)
 }
+
+func RunEnvironUpgradeSteps(envs []environs.Environ, controllerUUID string, from, to version.Number) error {
+	return runEnvironUpgradeSteps(envs, controllerUUID, from, to)
+}
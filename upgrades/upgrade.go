@@ -6,8 +6,11 @@ package upgrades
 import (
 	"fmt"
 
+	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/version"
+
+	jujuversion "github.com/juju/juju/version"
 )
 
 var logger = loggo.GetLogger("juju.upgrade")
@@ -85,9 +88,22 @@ func (e *upgradeError) Error() string {
 
 // AreUpgradesDefined returns true if there are upgrade operations
 // defined between the version supplied and the running software
-// version.
-func AreUpgradesDefined(from version.Number) bool {
-	return newUpgradeOpsIterator(from).Next() || newStateUpgradeOpsIterator(from).Next()
+// version. Controllers also run the upgrade operations of their
+// models' environs, so for them the providers' upgrade versions are
+// checked as well.
+func AreUpgradesDefined(from version.Number, isController bool) bool {
+	if newUpgradeOpsIterator(from).Next() || newStateUpgradeOpsIterator(from).Next() {
+		return true
+	}
+	if !isController {
+		return false
+	}
+	for _, target := range environUpgradeVersions() {
+		if from.Compare(target) < 0 && target.Compare(jujuversion.Current) <= 0 {
+			return true
+		}
+	}
+	return false
 }
 
 // PerformUpgrade runs the business logic needed to upgrade the current "from" version to this
@@ -99,6 +115,12 @@ func PerformUpgrade(from version.Number, targets []Target, context Context) erro
 			return err
 		}
 	}
+	if hasTarget(targets, DatabaseMaster) {
+		st := context.StateContext().State()
+		if err := upgradeEnvirons(st, from, jujuversion.Current); err != nil {
+			return errors.Annotate(err, "upgrading environs")
+		}
+	}
 
 	ops := newUpgradeOpsIterator(from)
 	if err := runUpgradeSteps(ops, targets, context.APIContext()); err != nil {
@@ -109,6 +131,15 @@ func PerformUpgrade(from version.Number, targets []Target, context Context) erro
 	return nil
 }
 
+func hasTarget(targets []Target, target Target) bool {
+	for _, t := range targets {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
+
 func hasStateTarget(targets []Target) bool {
 	for _, target := range targets {
 		if target == Controller || target == DatabaseMaster {
@@ -18,6 +18,7 @@ import (
 	"github.com/juju/juju/agent"
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/environs"
 	"github.com/juju/juju/mongo"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/multiwatcher"
@@ -64,6 +65,13 @@ type upgradeSuite struct {
 
 var _ = gc.Suite(&upgradeSuite{})
 
+func (s *upgradeSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.PatchValue(upgrades.ModelEnvirons, func(*state.State) ([]environs.Environ, error) {
+		return nil, nil
+	})
+}
+
 type mockUpgradeOperation struct {
 	targetVersion version.Number
 	steps         []upgrades.Step
@@ -290,11 +298,12 @@ func upgradeOperations() []upgrades.Operation {
 }
 
 type areUpgradesDefinedTest struct {
-	about       string
-	fromVersion string
-	toVersion   string
-	expected    bool
-	err         string
+	about        string
+	fromVersion  string
+	toVersion    string
+	isController bool
+	expected     bool
+	err          string
 }
 
 var areUpgradesDefinedTests = []areUpgradesDefinedTest{
@@ -337,11 +346,41 @@ var areUpgradesDefinedTests = []areUpgradesDefinedTest{
 		toVersion:   "1.21-beta5",
 		expected:    false,
 	},
+	{
+		about:        "true on controllers when environ ops defined between versions",
+		fromVersion:  "1.13.0",
+		toVersion:    "1.14.1",
+		isController: true,
+		expected:     true,
+	},
+	{
+		about:        "false on controllers when no environ ops defined between versions",
+		fromVersion:  "1.14.0",
+		toVersion:    "1.14.1",
+		isController: true,
+		expected:     false,
+	},
+	{
+		about:        "no environ ops on controllers if same version",
+		fromVersion:  "1.18.0",
+		isController: true,
+		expected:     false,
+	},
+	{
+		about:        "no environ ops on controllers for a new build",
+		fromVersion:  "1.18.0.1",
+		toVersion:    "1.18.0.2",
+		isController: true,
+		expected:     false,
+	},
 }
 
 func (s *upgradeSuite) TestAreUpgradesDefined(c *gc.C) {
 	s.PatchValue(upgrades.StateUpgradeOperations, stateUpgradeOperations)
 	s.PatchValue(upgrades.UpgradeOperations, upgradeOperations)
+	s.PatchValue(upgrades.EnvironUpgradeVersions, func() []version.Number {
+		return []version.Number{version.MustParse("1.14.0")}
+	})
 	for i, test := range areUpgradesDefinedTests {
 		c.Logf("%d: %s", i, test.about)
 		fromVersion := version.Zero
@@ -353,7 +392,7 @@ func (s *upgradeSuite) TestAreUpgradesDefined(c *gc.C) {
 			toVersion = version.MustParse(test.toVersion)
 		}
 		s.PatchValue(&jujuversion.Current, toVersion)
-		result := upgrades.AreUpgradesDefined(fromVersion)
+		result := upgrades.AreUpgradesDefined(fromVersion, test.isController)
 		c.Check(result, gc.Equals, test.expected)
 	}
 }
@@ -645,8 +684,16 @@ func (s *upgradeSuite) TestStateStepsNotAttemptedWhenNoStateTarget(c *gc.C) {
 }
 
 func (s *upgradeSuite) TestUpgradeOperationsOrdered(c *gc.C) {
+	assertOperationsOrdered(c, (*upgrades.UpgradeOperations)())
+}
+
+func (s *upgradeSuite) TestStateUpgradeOperationsOrdered(c *gc.C) {
+	assertOperationsOrdered(c, (*upgrades.StateUpgradeOperations)())
+}
+
+func assertOperationsOrdered(c *gc.C, ops []upgrades.Operation) {
 	var previous version.Number
-	for i, utv := range (*upgrades.UpgradeOperations)() {
+	for i, utv := range ops {
 		vers := utv.TargetVersion()
 		if i > 0 {
 			c.Check(previous.Compare(vers), gc.Equals, -1)
@@ -655,6 +702,16 @@ func (s *upgradeSuite) TestUpgradeOperationsOrdered(c *gc.C) {
 	}
 }
 
+func (s *upgradeSuite) TestUpgradeStepsDescribed(c *gc.C) {
+	ops := append((*upgrades.StateUpgradeOperations)(), (*upgrades.UpgradeOperations)()...)
+	for _, utv := range ops {
+		for _, step := range utv.Steps() {
+			c.Check(step.Description(), gc.Not(gc.Equals), "")
+			c.Check(step.Targets(), gc.Not(gc.HasLen), 0)
+		}
+	}
+}
+
 func (s *upgradeSuite) TestStateUpgradeOperationsVersions(c *gc.C) {
 	versions := extractUpgradeVersions(c, (*upgrades.StateUpgradeOperations)())
 	c.Assert(versions, gc.DeepEquals, []string{
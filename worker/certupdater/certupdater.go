@@ -176,7 +176,10 @@ func (c *CertificateUpdater) updateCertificate(addresses []network.Address, done
 }
 
 // updateRequired returns true and a list of merged addresses if any of the
-// new addresses are not yet contained in the server cert SAN list.
+// new addresses are not yet contained in the server cert SAN list. Both
+// the IP addresses and DNS names in the existing certificate are taken
+// into account, so a certificate is not needlessly regenerated for
+// hostnames it already covers.
 func updateRequired(serverCert string, newAddrs []string) ([]string, bool, error) {
 	x509Cert, err := cert.ParseCert(serverCert)
 	if err != nil {
@@ -186,6 +189,9 @@ func updateRequired(serverCert string, newAddrs []string) ([]string, bool, error
 	for _, ip := range x509Cert.IPAddresses {
 		existingAddr.Add(ip.String())
 	}
+	for _, name := range x509Cert.DNSNames {
+		existingAddr.Add(name)
+	}
 	logger.Debugf("existing cert addresses %v", existingAddr)
 	logger.Debugf("new addresses %v", newAddrs)
 	// Does newAddr contain any that are not already in existingAddr?
@@ -206,3 +206,27 @@ func (s *CertUpdaterSuite) TestAddressChangeNoCAKey(c *gc.C) {
 		c.Fatalf("set state serving info unexpectedly called")
 	}
 }
+
+func (s *CertUpdaterSuite) TestUpdateRequired(c *gc.C) {
+	addrs := []string{"localhost", "juju-apiserver", "juju-mongodb", "anything", "192.168.1.1"}
+	srvCert, _, err := jujucontroller.GenerateControllerCertAndKey(coretesting.CACert, coretesting.CAKey, addrs)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Neither the DNS names nor the IP address are new.
+	merged, update, err := certupdater.UpdateRequired(srvCert, addrs)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(update, jc.IsFalse)
+	c.Assert(merged, jc.SameContents, addrs)
+
+	// A new IP address requires an update, and is merged with the
+	// existing addresses.
+	merged, update, err = certupdater.UpdateRequired(srvCert, []string{"localhost", "0.1.2.3"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(update, jc.IsTrue)
+	c.Assert(merged, jc.SameContents, append(addrs, "0.1.2.3"))
+
+	// As does a new hostname.
+	_, update, err = certupdater.UpdateRequired(srvCert, []string{"controller.example.com"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(update, jc.IsTrue)
+}
@@ -0,0 +1,99 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package conv2state
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/agent"
+	apiagent "github.com/juju/juju/api/agent"
+	"github.com/juju/juju/api/base"
+	apimachiner "github.com/juju/juju/api/machiner"
+	"github.com/juju/juju/cmd/jujud/agent/engine"
+	"github.com/juju/juju/state/multiwatcher"
+	"github.com/juju/juju/watcher"
+	"github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/dependency"
+)
+
+// ManifoldConfig defines the names of the manifolds on which a
+// Manifold will depend.
+type ManifoldConfig struct {
+	AgentName     string
+	APICallerName string
+}
+
+// Validate is called by Manifold to check the configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.AgentName == "" {
+		return errors.NotValidf("empty AgentName")
+	}
+	if config.APICallerName == "" {
+		return errors.NotValidf("empty APICallerName")
+	}
+	return nil
+}
+
+// Manifold returns a dependency manifold that runs a worker which
+// restarts the machine agent when the machine is promoted to be a
+// controller. It uninstalls itself on machines that are already
+// controllers.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	typedConfig := engine.AgentAPIManifoldConfig{
+		AgentName:     config.AgentName,
+		APICallerName: config.APICallerName,
+	}
+	return engine.AgentAPIManifold(typedConfig, config.newWorker)
+}
+
+// newWorker starts a NotifyWorker running the converter, for use in an
+// engine.AgentAPIManifold.
+func (config ManifoldConfig) newWorker(a agent.Agent, apiCaller base.APICaller) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	tag, ok := a.CurrentConfig().Tag().(names.MachineTag)
+	if !ok {
+		return nil, errors.New("this manifold may only be used inside a machine agent")
+	}
+
+	entity, err := apiagent.NewState(apiCaller).Entity(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, job := range entity.Jobs() {
+		if job == multiwatcher.JobManageModel {
+			// Already a controller; there is nothing to convert.
+			return nil, dependency.ErrUninstall
+		}
+	}
+
+	handler := New(apimachiner.NewState(apiCaller), restartingAgent{tag})
+	w, err := watcher.NewNotifyWorker(watcher.NotifyConfig{
+		Handler: handler,
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot start controller promoter worker")
+	}
+	return w, nil
+}
+
+// restartingAgent implements Agent for an agent run by the dependency
+// engine, which restarts by stopping with worker.ErrRestartAgent. The
+// agent's process then exits, so that the init system restarts it
+// with its new controller jobs.
+type restartingAgent struct {
+	tag names.Tag
+}
+
+// Restart is part of the Agent interface.
+func (a restartingAgent) Restart() error {
+	return worker.ErrRestartAgent
+}
+
+// Tag is part of the Agent interface.
+func (a restartingAgent) Tag() names.Tag {
+	return a.tag
+}
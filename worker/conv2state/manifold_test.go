@@ -0,0 +1,49 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package conv2state
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker"
+)
+
+var _ = gc.Suite(&ManifoldSuite{})
+
+type ManifoldSuite struct {
+	coretesting.BaseSuite
+}
+
+func validManifoldConfig() ManifoldConfig {
+	return ManifoldConfig{
+		AgentName:     "agent",
+		APICallerName: "api-caller",
+	}
+}
+
+func (*ManifoldSuite) TestInputs(c *gc.C) {
+	manifold := Manifold(validManifoldConfig())
+	c.Assert(manifold.Inputs, jc.SameContents, []string{"agent", "api-caller"})
+}
+
+func (*ManifoldSuite) TestValidate(c *gc.C) {
+	c.Assert(validManifoldConfig().Validate(), jc.ErrorIsNil)
+
+	config := validManifoldConfig()
+	config.AgentName = ""
+	c.Assert(config.Validate(), gc.ErrorMatches, "empty AgentName not valid")
+
+	config = validManifoldConfig()
+	config.APICallerName = ""
+	c.Assert(config.Validate(), gc.ErrorMatches, "empty APICallerName not valid")
+}
+
+func (*ManifoldSuite) TestRestartingAgent(c *gc.C) {
+	a := restartingAgent{names.NewMachineTag("1")}
+	c.Assert(a.Tag(), gc.Equals, names.NewMachineTag("1"))
+	c.Assert(a.Restart(), gc.Equals, worker.ErrRestartAgent)
+}
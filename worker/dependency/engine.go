@@ -41,6 +41,22 @@ type EngineConfig struct {
 	// a worker that was deliberately stopped because its dependencies
 	// changed. It must not be negative.
 	BounceDelay time.Duration
+
+	// BackoffFactor, if greater than 1, multiplies the ErrorDelay
+	// for each consecutive unknown error returned by a manifold's
+	// worker, so that persistently failing workers are restarted
+	// progressively less often. It must not be negative.
+	BackoffFactor float64
+
+	// BackoffResetTime, if positive, is how long a worker must run
+	// before a subsequent failure is treated as a fresh one, rather
+	// than a consecutive one. It must not be negative.
+	BackoffResetTime time.Duration
+
+	// MaxDelay, if positive, is the longest the engine will wait
+	// before restarting a failed worker, however many consecutive
+	// failures it has seen. It must not be negative.
+	MaxDelay time.Duration
 }
 
 // Validate returns an error if any field is invalid.
@@ -57,6 +73,15 @@ func (config *EngineConfig) Validate() error {
 	if config.BounceDelay < 0 {
 		return errors.New("BounceDelay is negative")
 	}
+	if config.BackoffFactor < 0 {
+		return errors.New("BackoffFactor is negative")
+	}
+	if config.BackoffResetTime < 0 {
+		return errors.New("BackoffResetTime is negative")
+	}
+	if config.MaxDelay < 0 {
+		return errors.New("MaxDelay is negative")
+	}
 	return nil
 }
 
@@ -479,6 +504,8 @@ func (engine *Engine) gotStarted(name string, worker worker.Worker, resourceLog
 		engine.current[name] = workerInfo{
 			worker:      worker,
 			resourceLog: resourceLog,
+			failures:    info.failures,
+			startedTime: time.Now(),
 		}
 
 		// Any manifold that declares this one as an input needs to be restarted.
@@ -503,6 +530,14 @@ func (engine *Engine) gotStopped(name string, err error, resourceLog []resourceA
 		engine.tomb.Kill(nil)
 	}
 
+	// Work out how many times in a row the worker has failed; a worker
+	// that ran for long enough before failing gets a fresh start.
+	failures := info.failures
+	resetTime := engine.config.BackoffResetTime
+	if info.worker != nil && resetTime > 0 && time.Since(info.startedTime) > resetTime {
+		failures = 0
+	}
+
 	// Reset engine info; and bail out if we can be sure there's no need to bounce.
 	engine.current[name] = workerInfo{
 		err:         err,
@@ -535,9 +570,13 @@ func (engine *Engine) gotStopped(name string, err error, resourceLog []resourceA
 			// The task should never run again, and can be removed completely.
 			engine.uninstall(name)
 		default:
-			// Something went wrong but we don't know what. Try again soon.
+			// Something went wrong but we don't know what. Try again soon,
+			// backing off if it keeps happening.
 			logger.Errorf("%q manifold worker returned unexpected error: %v", name, err)
-			engine.requestStart(name, engine.config.ErrorDelay)
+			current := engine.current[name]
+			current.failures = failures + 1
+			engine.current[name] = current
+			engine.requestStart(name, engine.errorDelay(current.failures))
 		}
 	}
 
@@ -548,6 +587,25 @@ func (engine *Engine) gotStopped(name string, err error, resourceLog []resourceA
 	}
 }
 
+// errorDelay returns how long to wait before restarting a worker that has
+// failed the supplied number of consecutive times.
+func (engine *Engine) errorDelay(failures int) time.Duration {
+	delay := engine.config.ErrorDelay
+	maxDelay := engine.config.MaxDelay
+	if engine.config.BackoffFactor > 1 {
+		for i := 1; i < failures; i++ {
+			delay = time.Duration(float64(delay) * engine.config.BackoffFactor)
+			if maxDelay > 0 && delay > maxDelay {
+				break
+			}
+		}
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
 // requestStop ensures that any running or starting worker will be stopped in the
 // near future. It must only be called from the loop goroutine.
 func (engine *Engine) requestStop(name string) {
@@ -616,6 +674,12 @@ type workerInfo struct {
 	worker      worker.Worker
 	err         error
 	resourceLog []resourceAccess
+
+	// failures counts the consecutive unknown errors returned by the
+	// manifold's worker, and startedTime records when the current
+	// worker was started; together they determine the restart delay.
+	failures    int
+	startedTime time.Time
 }
 
 // stopped returns true unless the worker is either assigned or starting.
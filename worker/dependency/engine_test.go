@@ -332,6 +332,46 @@ func (s *EngineSuite) TestErrorRestartsDependents(c *gc.C) {
 	})
 }
 
+func (s *EngineSuite) TestErrorBacksOff(c *gc.C) {
+	s.fix.backoffFactor = 100
+	s.fix.run(c, func(engine *dependency.Engine) {
+
+		// Start a task.
+		mh1 := newManifoldHarness()
+		err := engine.Install("error-task", mh1.Manifold())
+		c.Assert(err, jc.ErrorIsNil)
+		mh1.AssertOneStart(c)
+
+		// The first error restarts it after the usual delay...
+		mh1.InjectError(c, errors.New("ZAP"))
+		mh1.AssertOneStart(c)
+
+		// ...but a consecutive one waits for considerably longer.
+		mh1.InjectError(c, errors.New("ZAP"))
+		mh1.AssertNoStart(c)
+		mh1.AssertStart(c)
+	})
+}
+
+func (s *EngineSuite) TestErrorBackoffLimitedByMaxDelay(c *gc.C) {
+	s.fix.backoffFactor = 100
+	s.fix.maxDelay = coretesting.ShortWait / 2
+	s.fix.run(c, func(engine *dependency.Engine) {
+
+		// Start a task.
+		mh1 := newManifoldHarness()
+		err := engine.Install("error-task", mh1.Manifold())
+		c.Assert(err, jc.ErrorIsNil)
+		mh1.AssertOneStart(c)
+
+		// Consecutive errors never delay the restart beyond MaxDelay.
+		mh1.InjectError(c, errors.New("ZAP"))
+		mh1.AssertOneStart(c)
+		mh1.InjectError(c, errors.New("ZAP"))
+		mh1.AssertOneStart(c)
+	})
+}
+
 func (s *EngineSuite) TestErrorPreservesDependencies(c *gc.C) {
 	s.fix.run(c, func(engine *dependency.Engine) {
 
@@ -687,6 +727,18 @@ func (s *EngineSuite) TestConfigValidate(c *gc.C) {
 		func(config *dependency.EngineConfig) {
 			config.BounceDelay = -time.Second
 		}, "BounceDelay is negative",
+	}, {
+		func(config *dependency.EngineConfig) {
+			config.BackoffFactor = -2
+		}, "BackoffFactor is negative",
+	}, {
+		func(config *dependency.EngineConfig) {
+			config.BackoffResetTime = -time.Second
+		}, "BackoffResetTime is negative",
+	}, {
+		func(config *dependency.EngineConfig) {
+			config.MaxDelay = -time.Second
+		}, "MaxDelay is negative",
 	}}
 
 	for i, test := range tests {
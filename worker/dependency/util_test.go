@@ -22,6 +22,9 @@ type engineFixture struct {
 	worstError dependency.WorstErrorFunc
 	filter     dependency.FilterFunc
 	dirty      bool
+
+	backoffFactor float64
+	maxDelay      time.Duration
 }
 
 func (fix *engineFixture) isFatalFunc() dependency.IsFatalFunc {
@@ -45,6 +48,9 @@ func (fix *engineFixture) run(c *gc.C, test func(*dependency.Engine)) {
 		Filter:      fix.filter, // can be nil anyway
 		ErrorDelay:  coretesting.ShortWait / 2,
 		BounceDelay: coretesting.ShortWait / 10,
+
+		BackoffFactor: fix.backoffFactor,
+		MaxDelay:      fix.maxDelay,
 	}
 
 	engine, err := dependency.NewEngine(config)
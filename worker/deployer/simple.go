@@ -19,6 +19,7 @@ import (
 	"github.com/juju/juju/agent"
 	"github.com/juju/juju/agent/tools"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/juju/osenv"
 	"github.com/juju/juju/service"
 	"github.com/juju/juju/service/common"
 	jujuversion "github.com/juju/juju/version"
@@ -223,7 +224,13 @@ func (ctx *SimpleContext) RecallUnit(unitName string) error {
 	}
 	// TODO(dfc) should take a Tag
 	toolsDir := tools.ToolsDir(dataDir, tag.String())
-	return os.Remove(toolsDir)
+	// The tools link may already have been removed by an earlier,
+	// interrupted, recall; that must not prevent the unit from
+	// being recalled now.
+	if err := os.Remove(toolsDir); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
 }
 
 var deployedRe = regexp.MustCompile("^(jujud-.*unit-([a-z0-9-]+)-([0-9]+))$")
@@ -273,6 +280,10 @@ func (ctx *SimpleContext) service(unitName string, renderer shell.Renderer) (dep
 		ctx.agentConfig.DataDir(),
 		ctx.agentConfig.LogDir(),
 	)
+	// Unit agents log in the same format as the machine agent
+	// deploying them.
+	info.LogFormat = os.Getenv(osenv.JujuLogFormatEnvKey)
+	info.LogLabels = map[string]string{"model": ctx.agentConfig.Model().Id()}
 
 	// TODO(thumper): 2013-09-02 bug 1219630
 	// As much as I'd like to remove JujuContainerType now, it is still
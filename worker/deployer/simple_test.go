@@ -78,6 +78,21 @@ func (s *SimpleContextSuite) TestDeployRecall(c *gc.C) {
 	s.checkUnitRemoved(c, "foo/123")
 }
 
+func (s *SimpleContextSuite) TestRecallMissingTools(c *gc.C) {
+	mgr0 := s.getContext(c)
+	err := mgr0.DeployUnit("foo/123", "some-password")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, toolsDir := s.paths(names.NewUnitTag("foo/123"))
+	err = os.Remove(toolsDir)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = mgr0.RecallUnit("foo/123")
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertUpstartCount(c, 0)
+	s.checkUnitRemoved(c, "foo/123")
+}
+
 func (s *SimpleContextSuite) TestOldDeployedUnitsCanBeRecalled(c *gc.C) {
 	// After r1347 deployer tag is no longer part of the upstart conf filenames,
 	// now only the units' tags are used. This change is with the assumption only
@@ -65,6 +65,7 @@ func listBlockDevices() ([]storage.BlockDevice, error) {
 		var dev storage.BlockDevice
 		var deviceType string
 		for _, pair := range pairs {
+			pair[2] = unescapeLsblkValue(pair[2])
 			switch pair[1] {
 			case "KNAME":
 				dev.DeviceName = pair[2]
@@ -132,6 +133,24 @@ func listBlockDevices() ([]storage.BlockDevice, error) {
 	return devices, nil
 }
 
+// lsblkEscapeRE matches the hexadecimal escape sequences that lsblk
+// uses for unsafe characters (e.g. whitespace) when outputting values
+// in key="value" pairs format.
+var lsblkEscapeRE = regexp.MustCompile(`\\x[0-9a-fA-F]{2}`)
+
+// unescapeLsblkValue replaces the "\xHH" escape sequences in a value
+// output by lsblk with the characters they represent, so that mount
+// points and labels containing spaces are reported verbatim.
+func unescapeLsblkValue(value string) string {
+	return lsblkEscapeRE.ReplaceAllStringFunc(value, func(escaped string) string {
+		b, err := strconv.ParseUint(escaped[2:], 16, 8)
+		if err != nil {
+			return escaped
+		}
+		return string([]byte{byte(b)})
+	})
+}
+
 // blockDeviceInUse checks if the specified block device
 // is in use by attempting to open the device exclusively.
 //
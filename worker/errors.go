@@ -18,6 +18,7 @@ import (
 // only when we want them to!) is kinda terrible.
 var (
 	ErrTerminateAgent  = errors.New("agent should be terminated")
+	ErrRestartAgent    = errors.New("agent should be restarted")
 	ErrRebootMachine   = errors.New("machine needs to reboot")
 	ErrShutdownMachine = errors.New("machine needs to shutdown")
 )
@@ -638,6 +638,12 @@ func (fw *Firewaller) flushInstancePorts(machined *machineData, toOpen, toClose
 		return err
 	}
 	instances, err := fw.environ.Instances([]instance.Id{instanceId})
+	if err == environs.ErrNoInstances {
+		// The instance has already gone away, taking its ports with
+		// it; the machine will be forgotten once it is removed.
+		logger.Debugf("instance %q for %q not found; not changing ports", instanceId, machined.tag)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -572,6 +572,35 @@ func (s *InstanceModeSuite) TestRemoveMachine(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *InstanceModeSuite) TestClosePortsOnMissingInstance(c *gc.C) {
+	fw, err := firewaller.NewFirewaller(s.firewaller)
+	c.Assert(err, jc.ErrorIsNil)
+	defer statetesting.AssertKillAndWait(c, fw)
+
+	app := s.AddTestingService(c, "wordpress", s.charm)
+	err = app.SetExposed()
+	c.Assert(err, jc.ErrorIsNil)
+
+	u1, m1 := s.addUnit(c, app)
+	inst1 := s.startInstance(c, m1)
+	err = u1.OpenPort("tcp", 80)
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertPorts(c, inst1, m1.Id(), []network.PortRange{{80, 80, "tcp"}})
+
+	// Stop the instance behind the firewaller's back, then close
+	// the unit's port; the firewaller should carry on regardless.
+	err = s.Environ.StopInstances(inst1.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	err = u1.ClosePort("tcp", 80)
+	c.Assert(err, jc.ErrorIsNil)
+
+	u2, m2 := s.addUnit(c, app)
+	inst2 := s.startInstance(c, m2)
+	err = u2.OpenPort("tcp", 8080)
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertPorts(c, inst2, m2.Id(), []network.PortRange{{8080, 8080, "tcp"}})
+}
+
 func (s *InstanceModeSuite) TestStartWithStateOpenPortsBroken(c *gc.C) {
 	app := s.AddTestingService(c, "wordpress", s.charm)
 	err := app.SetExposed()
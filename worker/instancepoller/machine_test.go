@@ -191,6 +191,59 @@ func (s *machineSuite) TestShortPollIntervalExponent(c *gc.C) {
 	c.Logf("actual count: %v; max %v", count, maxCount)
 }
 
+func (s *machineSuite) TestShortPollIntervalCappedAtLongPoll(c *gc.C) {
+	s.PatchValue(&ShortPoll, 1*time.Second)
+	s.PatchValue(&LongPoll, 3*time.Second)
+	s.PatchValue(&ShortPollBackoff, 2.0)
+
+	polled := make(chan struct{}, 1)
+	context := &testMachineContext{
+		getInstanceInfo: func(id instance.Id) (instanceInfo, error) {
+			polled <- struct{}{}
+			return instanceInfo{}, fmt.Errorf("no instance addresses available")
+		},
+		dyingc: make(chan struct{}),
+	}
+	m := &testMachine{
+		tag:        names.NewMachineTag("99"),
+		instanceId: "i1234",
+		refresh:    func() error { return nil },
+		life:       params.Alive,
+		status:     status.Started,
+	}
+	died := make(chan machine)
+
+	clock := gitjujutesting.NewClock(time.Time{})
+	go runMachine(context, m, nil, died, clock)
+
+	expectPoll := func() {
+		select {
+		case <-polled:
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("expected instance poll")
+		}
+	}
+	expectPoll()
+	// The interval backs off from ShortPoll after each poll,
+	// including the first, but never beyond LongPoll.
+	for _, interval := range []time.Duration{
+		2 * time.Second,
+		3 * time.Second,
+		3 * time.Second,
+	} {
+		select {
+		case <-clock.Alarms():
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("expected time-based polling")
+		}
+		clock.Advance(interval)
+		expectPoll()
+	}
+
+	killMachineLoop(c, m, context.dyingc, died)
+	c.Assert(context.killErr, gc.Equals, nil)
+}
+
 func (s *machineSuite) TestLongPollIntervalWhenHasAllInstanceInfo(c *gc.C) {
 	s.PatchValue(&ShortPoll, coretesting.LongWait)
 	s.PatchValue(&LongPoll, 1*time.Millisecond)
@@ -198,6 +251,40 @@ func (s *machineSuite) TestLongPollIntervalWhenHasAllInstanceInfo(c *gc.C) {
 	c.Assert(count, jc.GreaterThan, 2)
 }
 
+func (s *machineSuite) TestShortPollIntervalAfterAddressesChange(c *gc.C) {
+	s.PatchValue(&ShortPoll, 1*time.Microsecond)
+	s.PatchValue(&LongPoll, coretesting.LongWait)
+	s.PatchValue(&ShortPollBackoff, 2.0)
+
+	count := int32(0)
+	context := &testMachineContext{
+		getInstanceInfo: func(id instance.Id) (instanceInfo, error) {
+			atomic.AddInt32(&count, 1)
+			return instanceInfo{testAddrs, instance.InstanceStatus{Status: status.Unknown, Message: "running"}}, nil
+		},
+		dyingc: make(chan struct{}),
+	}
+	// The machine starts without the instance's addresses, so the
+	// first poll changes them; the poller should then back off from
+	// ShortPoll rather than going straight to LongPoll.
+	m := &testMachine{
+		tag:        names.NewMachineTag("99"),
+		instanceId: "i1234",
+		refresh:    func() error { return nil },
+		life:       params.Alive,
+		status:     status.Started,
+	}
+	died := make(chan machine)
+
+	go runMachine(context, m, nil, died, clock.WallClock)
+
+	time.Sleep(coretesting.ShortWait)
+	killMachineLoop(c, m, context.dyingc, died)
+	c.Assert(context.killErr, gc.Equals, nil)
+	c.Assert(m.addresses, gc.DeepEquals, testAddrs)
+	c.Assert(int(atomic.LoadInt32(&count)), jc.GreaterThan, 2)
+}
+
 // countPolls sets up a machine loop with the given
 // addresses and status to be returned from getInstanceInfo,
 // waits for coretesting.ShortWait, and returns the
@@ -175,11 +175,19 @@ func machineLoop(context machineContext, m machine, lifeChanged <-chan struct{},
 	// a machine's address and machine agent to start, and a long one when it already
 	// has an address and the machine agent is started.
 	pollInterval := ShortPoll
+	// settling is true while the poll interval is backing off after
+	// the machine's addresses have changed, so that any further
+	// cloud-side changes are picked up promptly.
+	settling := false
 	pollInstance := func() error {
-		instInfo, err := pollInstanceInfo(context, m)
+		instInfo, addressesChanged, err := pollInstanceInfo(context, m)
 		if err != nil {
 			return err
 		}
+		if addressesChanged {
+			pollInterval = ShortPoll
+			settling = true
+		}
 
 		machineStatus := status.Pending
 		if err == nil {
@@ -194,13 +202,18 @@ func machineLoop(context machineContext, m machine, lifeChanged <-chan struct{},
 		// the extra condition below (checking allocating/pending) is here to improve user experience
 		// without it the instance status will say "pending" for +10 minutes after the agent comes up to "started"
 		if instInfo.status.Status != status.Allocating && instInfo.status.Status != status.Pending {
-			if len(instInfo.addresses) > 0 && machineStatus == status.Started {
+			if len(instInfo.addresses) > 0 && machineStatus == status.Started && !settling {
 				// We've got at least one address and a status and instance is started, so poll infrequently.
 				pollInterval = LongPoll
 			} else if pollInterval < LongPoll {
 				// We have no addresses or not started - poll increasingly rarely
 				// until we do.
 				pollInterval = time.Duration(float64(pollInterval) * ShortPollBackoff)
+				if pollInterval > LongPoll {
+					pollInterval = LongPoll
+				}
+			} else {
+				settling = false
 			}
 		}
 		return nil
@@ -234,24 +247,25 @@ func machineLoop(context machineContext, m machine, lifeChanged <-chan struct{},
 
 // pollInstanceInfo checks the current provider addresses and status
 // for the given machine's instance, and sets them on the machine if they've changed.
-func pollInstanceInfo(context machineContext, m machine) (instInfo instanceInfo, err error) {
+// It also reports whether the machine's addresses were changed.
+func pollInstanceInfo(context machineContext, m machine) (instInfo instanceInfo, addressesChanged bool, err error) {
 	instInfo = instanceInfo{}
 	instId, err := m.InstanceId()
 	// We can't ask the machine for its addresses if it isn't provisioned yet.
 	if params.IsCodeNotProvisioned(err) {
-		return instanceInfo{}, err
+		return instanceInfo{}, false, err
 	}
 	if err != nil {
-		return instanceInfo{}, errors.Annotate(err, "cannot get machine's instance id")
+		return instanceInfo{}, false, errors.Annotate(err, "cannot get machine's instance id")
 	}
 	instInfo, err = context.instanceInfo(instId)
 	if err != nil {
 		// TODO (anastasiamac 2016-02-01) This does not look like it needs to be removed now.
 		if params.IsCodeNotImplemented(err) {
-			return instanceInfo{}, err
+			return instanceInfo{}, false, err
 		}
 		logger.Warningf("cannot get instance info for instance %q: %v", instId, err)
-		return instInfo, nil
+		return instInfo, false, nil
 	}
 	if instStat, err := m.InstanceStatus(); err != nil {
 		// This should never occur since the machine is provisioned.
@@ -268,7 +282,7 @@ func pollInstanceInfo(context machineContext, m machine) (instInfo instanceInfo,
 			logger.Infof("machine %q instance status changed from %q to %q", m.Id(), currentInstStatus, instInfo.status)
 			if err = m.SetInstanceStatus(instInfo.status.Status, instInfo.status.Message, nil); err != nil {
 				logger.Errorf("cannot set instance status on %q: %v", m, err)
-				return instanceInfo{}, err
+				return instanceInfo{}, false, err
 			}
 		}
 
@@ -276,17 +290,18 @@ func pollInstanceInfo(context machineContext, m machine) (instInfo instanceInfo,
 	if m.Life() != params.Dead {
 		providerAddresses, err := m.ProviderAddresses()
 		if err != nil {
-			return instanceInfo{}, err
+			return instanceInfo{}, false, err
 		}
 		if !addressesEqual(providerAddresses, instInfo.addresses) {
 			logger.Infof("machine %q has new addresses: %v", m.Id(), instInfo.addresses)
 			if err := m.SetProviderAddresses(instInfo.addresses...); err != nil {
 				logger.Errorf("cannot set addresses on %q: %v", m, err)
-				return instanceInfo{}, err
+				return instanceInfo{}, false, err
 			}
+			addressesChanged = true
 		}
 	}
-	return instInfo, nil
+	return instInfo, addressesChanged, nil
 }
 
 // addressesEqual compares the addresses of the machine and the instance information.
@@ -88,6 +88,7 @@ func (w *socketListener) serve() {
 	mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
 	mux.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
 	mux.Handle("/depengine/", http.HandlerFunc(w.depengineReport))
+	mux.Handle("/metrics/", http.HandlerFunc(metricsReport))
 
 	srv := http.Server{
 		Handler: mux,
@@ -137,3 +138,38 @@ func (s *socketListener) depengineReport(w http.ResponseWriter, r *http.Request)
 	fmt.Fprint(w, "Dependency Engine Report\n\n")
 	w.Write(bytes)
 }
+
+// metricsReport writes out a summary of the Go runtime's metrics for
+// the agent: goroutine count, memory usage and garbage collection.
+func metricsReport(w http.ResponseWriter, r *http.Request) {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	bytes, err := yaml.Marshal(map[string]interface{}{
+		"goroutines": runtime.NumGoroutine(),
+		"memory": map[string]interface{}{
+			"alloc":        stats.Alloc,
+			"total-alloc":  stats.TotalAlloc,
+			"sys":          stats.Sys,
+			"heap-alloc":   stats.HeapAlloc,
+			"heap-objects": stats.HeapObjects,
+			"stack-inuse":  stats.StackInuse,
+			"mallocs":      stats.Mallocs,
+			"frees":        stats.Frees,
+		},
+		"gc": map[string]interface{}{
+			"num-gc":         stats.NumGC,
+			"pause-total-ns": stats.PauseTotalNs,
+			"next-gc":        stats.NextGC,
+		},
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "error: %v\n", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	fmt.Fprint(w, "Runtime Metrics\n\n")
+	w.Write(bytes)
+}
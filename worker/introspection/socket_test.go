@@ -127,6 +127,15 @@ func (s *introspectionSuite) TestEngineReporter(c *gc.C) {
 	matches(c, buf, "working: true")
 }
 
+func (s *introspectionSuite) TestMetrics(c *gc.C) {
+	buf := s.call(c, "/metrics/")
+
+	matches(c, buf, "200 OK")
+	matches(c, buf, "Runtime Metrics")
+	matches(c, buf, `^goroutines: \d+`)
+	matches(c, buf, `^  heap-alloc: \d+`)
+}
+
 // matches fails if regex is not found in the contents of b.
 // b is expected to be the response from the pprof http server, and will
 // contain some HTTP preamble that should be ignored.
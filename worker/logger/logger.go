@@ -42,22 +42,30 @@ func NewLogger(api *logger.State, agentConfig agent.Config) (worker.Worker, erro
 }
 
 func (logger *Logger) setLogging() {
-	loggingConfig, err := logger.api.LoggingConfig(logger.agentConfig.Tag())
-	if err != nil {
-		log.Errorf("%v", err)
+	loggingConfig := logger.agentConfig.Value(agent.LoggingOverride)
+	if loggingConfig != "" {
+		log.Tracef("using logging override %q from agent config", loggingConfig)
 	} else {
-		if loggingConfig != logger.lastConfig {
-			log.Debugf("reconfiguring logging from %q to %q", logger.lastConfig, loggingConfig)
-			loggo.DefaultContext().ResetLoggerLevels()
-			if err := loggo.ConfigureLoggers(loggingConfig); err != nil {
-				// This shouldn't occur as the loggingConfig should be
-				// validated by the original Config before it gets here.
-				log.Warningf("configure loggers failed: %v", err)
-				// Try to reset to what we had before
-				loggo.ConfigureLoggers(logger.lastConfig)
-			}
-			logger.lastConfig = loggingConfig
+		var err error
+		loggingConfig, err = logger.api.LoggingConfig(logger.agentConfig.Tag())
+		if err != nil {
+			log.Errorf("%v", err)
+			return
+		}
+	}
+	if loggingConfig != logger.lastConfig {
+		log.Debugf("reconfiguring logging from %q to %q", logger.lastConfig, loggingConfig)
+		loggo.DefaultContext().ResetLoggerLevels()
+		if err := loggo.ConfigureLoggers(loggingConfig); err != nil {
+			// This shouldn't occur as the loggingConfig should be
+			// validated by the original Config before it gets here.
+			log.Warningf("configure loggers failed: %v", err)
+			// Try to reset to what we had before, and keep it as
+			// the last known config so a later fix is applied.
+			loggo.ConfigureLoggers(logger.lastConfig)
+			return
 		}
+		logger.lastConfig = loggingConfig
 	}
 }
 
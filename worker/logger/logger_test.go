@@ -62,16 +62,33 @@ func (s *LoggerSuite) waitLoggingInfo(c *gc.C, expected string) {
 
 type mockConfig struct {
 	agent.Config
-	c   *gc.C
-	tag names.Tag
+	c      *gc.C
+	tag    names.Tag
+	values map[string]string
+
+	// overrideRead, if not nil, is sent a value whenever the
+	// logging override is read, which the worker does each time
+	// it sets up logging.
+	overrideRead chan struct{}
 }
 
 func (mock *mockConfig) Tag() names.Tag {
 	return mock.tag
 }
 
+func (mock *mockConfig) Value(key string) string {
+	if key == agent.LoggingOverride && mock.overrideRead != nil {
+		select {
+		case mock.overrideRead <- struct{}{}:
+		default:
+			mock.c.Errorf("logging override read not consumed")
+		}
+	}
+	return mock.values[key]
+}
+
 func agentConfig(c *gc.C, tag names.Tag) *mockConfig {
-	return &mockConfig{c: c, tag: tag}
+	return &mockConfig{c: c, tag: tag, values: make(map[string]string)}
 }
 
 func (s *LoggerSuite) makeLogger(c *gc.C) (worker.Worker, *mockConfig) {
@@ -103,3 +120,39 @@ func (s *LoggerSuite) TestInitialState(c *gc.C) {
 
 	s.waitLoggingInfo(c, expected)
 }
+
+func (s *LoggerSuite) TestLoggingOverride(c *gc.C) {
+	override := "<root>=TRACE"
+	loggo.DefaultContext().ResetLoggerLevels()
+
+	config := agentConfig(c, s.machine.Tag())
+	config.values[agent.LoggingOverride] = override
+	config.overrideRead = make(chan struct{}, 10)
+	loggingWorker, err := logger.NewLogger(s.loggerAPI, config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer worker.Stop(loggingWorker)
+
+	// The worker sets up logging once when it starts, and again
+	// for the watcher's initial event.
+	waitOverrideRead(c, config)
+	waitOverrideRead(c, config)
+	c.Assert(loggo.LoggerInfo(), gc.Equals, override)
+
+	// Changes to the model's logging config are ignored.
+	err = s.State.UpdateModelConfig(map[string]interface{}{
+		"logging-config": "<root>=ERROR",
+	}, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	waitOverrideRead(c, config)
+	// Stopping the worker waits for it to finish handling the change.
+	c.Assert(worker.Stop(loggingWorker), jc.ErrorIsNil)
+	c.Assert(loggo.LoggerInfo(), gc.Equals, override)
+}
+
+func waitOverrideRead(c *gc.C, config *mockConfig) {
+	select {
+	case <-config.overrideRead:
+	case <-time.After(worstCase):
+		c.Fatalf("timed out waiting for the logging override to be read")
+	}
+}
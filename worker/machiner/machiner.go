@@ -181,14 +181,16 @@ func (mr *Machiner) Handle(_ <-chan struct{}) error {
 	// assigned, or storage attached, this will fail with
 	// CodeHasAssignedUnits or CodeMachineHasAttachedStorage respectively.
 	// Once units or storage are removed, the watcher will trigger again
-	// and we'll reattempt.
+	// and we'll reattempt. In the meantime, the status records what the
+	// machine is waiting for.
 	if err := mr.machine.EnsureDead(); err != nil {
 		if params.IsCodeHasAssignedUnits(err) {
-			return nil
+			logger.Tracef("machine still has units assigned")
+			return mr.setStoppedStatus("waiting for units to be removed")
 		}
 		if params.IsCodeMachineHasAttachedStorage(err) {
 			logger.Tracef("machine still has storage attached")
-			return nil
+			return mr.setStoppedStatus("waiting for storage to be detached")
 		}
 		return errors.Annotatef(err, "%s failed to set machine to dead", mr.config.Tag)
 	}
@@ -204,6 +206,15 @@ func (mr *Machiner) Handle(_ <-chan struct{}) error {
 	return worker.ErrTerminateAgent
 }
 
+// setStoppedStatus sets the machine's status to stopped, with the
+// given message explaining why it is not yet dead.
+func (mr *Machiner) setStoppedStatus(message string) error {
+	if err := mr.machine.SetStatus(status.Stopped, message, nil); err != nil {
+		return errors.Annotatef(err, "%s failed to set status stopped", mr.config.Tag)
+	}
+	return nil
+}
+
 func (mr *Machiner) TearDown() error {
 	// Nothing to do here.
 	return nil
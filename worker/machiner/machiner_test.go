@@ -190,6 +190,13 @@ func (s *MachinerSuite) TestMachinerMachineAssignedUnits(c *gc.C) {
 		"Life",
 		"SetStatus",
 		"EnsureDead",
+		"SetStatus",
+	)
+	s.accessor.machine.CheckCall(
+		c, 7, "SetStatus",
+		status.Stopped,
+		"waiting for units to be removed",
+		map[string]interface{}(nil),
 	)
 }
 
@@ -251,6 +258,13 @@ func (s *MachinerSuite) TestMachinerStorageAttached(c *gc.C) {
 		},
 	}, {
 		FuncName: "EnsureDead",
+	}, {
+		FuncName: "SetStatus",
+		Args: []interface{}{
+			status.Stopped,
+			"waiting for storage to be detached",
+			map[string]interface{}(nil),
+		},
 	}})
 }
 
@@ -10,21 +10,31 @@ import (
 
 	"github.com/juju/juju/instance"
 	"github.com/juju/juju/network"
+	"github.com/juju/juju/pubsub"
 )
 
 type apiHostPortsSetter interface {
 	SetAPIHostPorts([][]network.HostPort) error
 }
 
+// messagePublisher is the part of *pubsub.Hub used to tell the other
+// workers on the controllers about changed API addresses.
+type messagePublisher interface {
+	Publish(topic string, data map[string]interface{})
+}
+
 type publisher struct {
-	st apiHostPortsSetter
+	st  apiHostPortsSetter
+	hub messagePublisher
 
 	mu             sync.Mutex
 	lastAPIServers [][]network.HostPort
 }
 
-func newPublisher(st apiHostPortsSetter) *publisher {
-	return &publisher{st: st}
+// newPublisher returns a publisher that records API addresses in state,
+// and, if hub is not nil, publishes them on the APIDetailsTopic.
+func newPublisher(st apiHostPortsSetter, hub messagePublisher) *publisher {
+	return &publisher{st: st, hub: hub}
 }
 
 func (pub *publisher) publishAPIServers(apiServers [][]network.HostPort, instanceIds []instance.Id) error {
@@ -50,9 +60,27 @@ func (pub *publisher) publishAPIServers(apiServers [][]network.HostPort, instanc
 		return err
 	}
 	pub.lastAPIServers = sortedAPIServers
+	if pub.hub != nil {
+		pub.hub.Publish(pubsub.APIDetailsTopic, apiDetails(sortedAPIServers))
+	}
 	return nil
 }
 
+// apiDetails returns the payload of an APIDetailsTopic message: the
+// "servers" key holds, for each controller, its API addresses in
+// host:port form.
+func apiDetails(apiServers [][]network.HostPort) map[string]interface{} {
+	servers := make([]interface{}, len(apiServers))
+	for i, hostPorts := range apiServers {
+		addrs := make([]interface{}, len(hostPorts))
+		for j, hp := range hostPorts {
+			addrs[j] = hp.NetAddr()
+		}
+		servers[i] = addrs
+	}
+	return map[string]interface{}{"servers": servers}
+}
+
 func apiServersEqual(a, b [][]network.HostPort) bool {
 	if len(a) != len(b) {
 		return false
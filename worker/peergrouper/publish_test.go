@@ -8,6 +8,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/network"
+	"github.com/juju/juju/pubsub"
 	"github.com/juju/juju/testing"
 )
 
@@ -30,7 +31,7 @@ func (s *mockAPIHostPortsSetter) SetAPIHostPorts(apiHostPorts [][]network.HostPo
 
 func (s *publishSuite) TestPublisherSetsAPIHostPortsOnce(c *gc.C) {
 	var mock mockAPIHostPortsSetter
-	statePublish := newPublisher(&mock)
+	statePublish := newPublisher(&mock, nil)
 
 	hostPorts1 := network.NewHostPorts(1234, "testing1.invalid", "127.0.0.1")
 	hostPorts2 := network.NewHostPorts(1234, "testing2.invalid", "127.0.0.2")
@@ -60,7 +61,7 @@ func (s *publishSuite) TestPublisherSortsHostPorts(c *gc.C) {
 
 	check := func(publish, expect []network.HostPort) {
 		var mock mockAPIHostPortsSetter
-		statePublish := newPublisher(&mock)
+		statePublish := newPublisher(&mock, nil)
 		for i := 0; i < 2; i++ {
 			err := statePublish.publishAPIServers([][]network.HostPort{publish}, nil)
 			c.Assert(err, jc.ErrorIsNil)
@@ -75,7 +76,38 @@ func (s *publishSuite) TestPublisherSortsHostPorts(c *gc.C) {
 
 func (s *publishSuite) TestPublisherRejectsNoServers(c *gc.C) {
 	var mock mockAPIHostPortsSetter
-	statePublish := newPublisher(&mock)
+	statePublish := newPublisher(&mock, nil)
 	err := statePublish.PublishAPIServers(nil, nil)
 	c.Assert(err, gc.ErrorMatches, "no api servers specified")
 }
+
+type mockHub struct {
+	topics []string
+	data   []map[string]interface{}
+}
+
+func (h *mockHub) Publish(topic string, data map[string]interface{}) {
+	h.topics = append(h.topics, topic)
+	h.data = append(h.data, data)
+}
+
+func (s *publishSuite) TestPublisherPublishesOnHub(c *gc.C) {
+	var mock mockAPIHostPortsSetter
+	var hub mockHub
+	statePublish := newPublisher(&mock, &hub)
+
+	hostPorts := network.NewHostPorts(1234, "testing1.invalid", "127.0.0.1")
+	apiServers := [][]network.HostPort{hostPorts}
+	for i := 0; i < 2; i++ {
+		err := statePublish.publishAPIServers(apiServers, nil)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	// Unchanged addresses are published only once.
+	c.Assert(hub.topics, jc.DeepEquals, []string{pubsub.APIDetailsTopic})
+	c.Assert(hub.data, jc.DeepEquals, []map[string]interface{}{{
+		"servers": []interface{}{
+			[]interface{}{"testing1.invalid:1234", "127.0.0.1:1234"},
+		},
+	}})
+}
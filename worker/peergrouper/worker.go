@@ -13,6 +13,7 @@ import (
 
 	"github.com/juju/juju/instance"
 	"github.com/juju/juju/network"
+	"github.com/juju/juju/pubsub"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/status"
 	"github.com/juju/juju/worker"
@@ -104,8 +105,9 @@ type pgWorker struct {
 }
 
 // New returns a new worker that maintains the mongo replica set
-// with respect to the given state.
-func New(st *state.State, supportsSpaces bool) (worker.Worker, error) {
+// with respect to the given state. Changes to the API addresses are
+// also published on hub, if it is not nil.
+func New(st *state.State, supportsSpaces bool, hub *pubsub.Hub) (worker.Worker, error) {
 	cfg, err := st.ControllerConfig()
 	if err != nil {
 		return nil, err
@@ -115,7 +117,11 @@ func New(st *state.State, supportsSpaces bool) (worker.Worker, error) {
 		mongoPort: cfg.StatePort(),
 		apiPort:   cfg.APIPort(),
 	}
-	return newWorker(shim, newPublisher(st), supportsSpaces)
+	var pub messagePublisher
+	if hub != nil {
+		pub = hub
+	}
+	return newWorker(shim, newPublisher(st, pub), supportsSpaces)
 }
 
 func newWorker(st stateInterface, pub publisherInterface, supportsSpaces bool) (worker.Worker, error) {
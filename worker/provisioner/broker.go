@@ -4,6 +4,8 @@
 package provisioner
 
 import (
+	"net"
+
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/utils/arch"
@@ -38,6 +40,56 @@ func (h hostArchToolsFinder) FindTools(v version.Number, series, _ string) (tool
 // system. Defined here so it can be overriden for testing.
 var resolvConf = "/etc/resolv.conf"
 
+// interfaceAddrs returns the addresses of the local system's network
+// interfaces. Defined here so it can be overridden for testing.
+var interfaceAddrs = net.InterfaceAddrs
+
+// containerBridge returns the bridge that a new container should be
+// attached to, and whether the provider should be asked to allocate
+// the container's addresses, according to the model's container
+// networking method. With "local" and "fan" networking, containers
+// get their addresses by DHCP on the local or fan bridge respectively.
+func containerBridge(config params.ContainerConfig, defaultBridge string) (string, bool, error) {
+	switch config.ContainerNetworkingMethod {
+	case "local":
+		return defaultBridge, false, nil
+	case "fan":
+		bridge, err := fanBridge(config.FanConfig)
+		if err != nil {
+			return "", false, errors.Trace(err)
+		}
+		return bridge, false, nil
+	}
+	return defaultBridge, true, nil
+}
+
+// fanBridge returns the name of the fan bridge for the overlay whose
+// underlay contains one of the host's addresses.
+func fanBridge(fanConfigString string) (string, error) {
+	fanConfig, err := network.ParseFanConfig(fanConfigString)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	addrs, err := interfaceAddrs()
+	if err != nil {
+		return "", errors.Annotate(err, "cannot get host addresses")
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		entry, err := fanConfig.EntryForAddress(ipNet.IP)
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return "", errors.Trace(err)
+		}
+		return entry.BridgeName(), nil
+	}
+	return "", errors.NotFoundf("fan underlay for any host address")
+}
+
 func prepareOrGetContainerInterfaceInfo(
 	api APICalls,
 	machineID string,
@@ -28,6 +28,7 @@ func GetRetryWatcher(p Provisioner) (watcher.NotifyWatcher, error) {
 var (
 	ContainerManagerConfig = containerManagerConfig
 	GetToolsFinder         = &getToolsFinder
+	InterfaceAddrs         = &interfaceAddrs
 	ResolvConf             = &resolvConf
 	RetryStrategyDelay     = &retryStrategyDelay
 	RetryStrategyCount     = &retryStrategyCount
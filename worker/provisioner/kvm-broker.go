@@ -59,20 +59,28 @@ func (broker *kvmBroker) StartInstance(args environs.StartInstanceParams) (*envi
 		return nil, err
 	}
 
-	preparedInfo, err := prepareOrGetContainerInterfaceInfo(
-		broker.api,
-		machineId,
-		bridgeDevice,
-		true, // allocate if possible, do not maintain existing.
-		args.NetworkInfo,
-		kvmLogger,
-	)
+	bridgeDevice, allocate, err := containerBridge(config, bridgeDevice)
 	if err != nil {
-		// It's not fatal (yet) if we couldn't pre-allocate addresses for the
-		// container.
-		logger.Warningf("failed to prepare container %q network config: %v", machineId, err)
-	} else {
-		args.NetworkInfo = preparedInfo
+		return nil, errors.Annotatef(err, "cannot select bridge for container %q", machineId)
+	}
+	if allocate {
+		preparedInfo, err := prepareOrGetContainerInterfaceInfo(
+			broker.api,
+			machineId,
+			bridgeDevice,
+			true, // allocate if possible, do not maintain existing.
+			args.NetworkInfo,
+			kvmLogger,
+		)
+		if err != nil && config.ContainerNetworkingMethod == "provider" {
+			return nil, errors.Annotatef(err, "cannot prepare container %q network config", machineId)
+		} else if err != nil {
+			// It's not fatal (yet) if we couldn't pre-allocate addresses for the
+			// container.
+			logger.Warningf("failed to prepare container %q network config: %v", machineId, err)
+		} else {
+			args.NetworkInfo = preparedInfo
+		}
 	}
 
 	network := container.BridgeNetworkConfig(bridgeDevice, 0, args.NetworkInfo)
@@ -4,6 +4,7 @@
 package provisioner_test
 
 import (
+	"net"
 	"runtime"
 
 	"github.com/juju/errors"
@@ -126,6 +127,71 @@ func (s *lxdBrokerSuite) TestStartInstancePopulatesFallbackNetworkInfo(c *gc.C)
 	}})
 }
 
+func (s *lxdBrokerSuite) TestStartInstanceLocalNetworking(c *gc.C) {
+	patchResolvConf(s, c)
+	s.api.fakeContainerConfig.ContainerNetworkingMethod = "local"
+
+	result := s.startInstance(c, "1/lxd/0")
+	s.api.CheckCallNames(c, "ContainerConfig")
+	c.Assert(result.NetworkInfo, gc.HasLen, 1)
+	c.Assert(result.NetworkInfo[0].ConfigType, gc.Equals, network.ConfigDHCP)
+	c.Assert(result.NetworkInfo[0].ParentInterfaceName, gc.Equals, "lxdbr0")
+}
+
+func (s *lxdBrokerSuite) TestStartInstanceFanNetworking(c *gc.C) {
+	patchResolvConf(s, c)
+	s.PatchValue(provisioner.InterfaceAddrs, func() ([]net.Addr, error) {
+		return []net.Addr{
+			&net.IPNet{IP: net.ParseIP("127.0.0.1"), Mask: net.CIDRMask(8, 32)},
+			&net.IPNet{IP: net.ParseIP("10.1.2.3"), Mask: net.CIDRMask(16, 32)},
+		}, nil
+	})
+	s.api.fakeContainerConfig.ContainerNetworkingMethod = "fan"
+	s.api.fakeContainerConfig.FanConfig = "10.0.0.0/16=253.0.0.0/8"
+
+	result := s.startInstance(c, "1/lxd/0")
+	s.api.CheckCallNames(c, "ContainerConfig")
+	c.Assert(result.NetworkInfo, gc.HasLen, 1)
+	c.Assert(result.NetworkInfo[0].ConfigType, gc.Equals, network.ConfigDHCP)
+	c.Assert(result.NetworkInfo[0].ParentInterfaceName, gc.Equals, "fan-253")
+}
+
+func (s *lxdBrokerSuite) TestStartInstanceFanNetworkingNoUnderlay(c *gc.C) {
+	s.PatchValue(provisioner.InterfaceAddrs, func() ([]net.Addr, error) {
+		return []net.Addr{
+			&net.IPNet{IP: net.ParseIP("192.168.0.1"), Mask: net.CIDRMask(24, 32)},
+		}, nil
+	})
+	s.api.fakeContainerConfig.ContainerNetworkingMethod = "fan"
+	s.api.fakeContainerConfig.FanConfig = "10.0.0.0/16=253.0.0.0/8"
+
+	_, err := s.broker.StartInstance(environs.StartInstanceParams{
+		Constraints:    constraints.Value{},
+		Tools:          makePossibleTools(),
+		InstanceConfig: makeInstanceConfig(c, s, "1/lxd/0"),
+		StatusCallback: makeNoOpStatusCallback(),
+	})
+	c.Assert(err, gc.ErrorMatches, `cannot select bridge for container "1/lxd/0": fan underlay for any host address not found`)
+	s.manager.CheckNoCalls(c)
+}
+
+func (s *lxdBrokerSuite) TestStartInstanceProviderNetworkingFails(c *gc.C) {
+	s.api.fakeContainerConfig.ContainerNetworkingMethod = "provider"
+	s.api.SetErrors(
+		nil, // ContainerConfig succeeds
+		errors.NotSupportedf("container address allocation"),
+	)
+
+	_, err := s.broker.StartInstance(environs.StartInstanceParams{
+		Constraints:    constraints.Value{},
+		Tools:          makePossibleTools(),
+		InstanceConfig: makeInstanceConfig(c, s, "1/lxd/0"),
+		StatusCallback: makeNoOpStatusCallback(),
+	})
+	c.Assert(err, gc.ErrorMatches, `cannot prepare container "1/lxd/0" network config: container address allocation not supported`)
+	s.manager.CheckNoCalls(c)
+}
+
 func (s *lxdBrokerSuite) TestStartInstanceNoHostArchTools(c *gc.C) {
 	_, err := s.broker.StartInstance(environs.StartInstanceParams{
 		Tools: coretools.List{{
@@ -16,10 +16,11 @@ import (
 
 // ManifoldConfig defines the names of the manifolds on which a Manifold will depend.
 type ManifoldConfig struct {
-	AgentName      string
-	APICallerName  string
-	WorkerFunc     func(Config) (worker.Worker, error)
-	ExternalUpdate func(proxy.Settings) error
+	AgentName       string
+	APICallerName   string
+	WorkerFunc      func(Config) (worker.Worker, error)
+	ExternalUpdate  func(proxy.Settings) error
+	RunSnapCommands func(string) error
 }
 
 // Manifold returns a dependency manifold that runs a proxy updater worker,
@@ -49,11 +50,12 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				return nil, err
 			}
 			w, err := config.WorkerFunc(Config{
-				Directory:      "/home/ubuntu",
-				RegistryPath:   `HKCU:\Software\Microsoft\Windows\CurrentVersion\Internet Settings`,
-				Filename:       ".juju-proxy",
-				API:            proxyAPI,
-				ExternalUpdate: config.ExternalUpdate,
+				Directory:       "/home/ubuntu",
+				RegistryPath:    `HKCU:\Software\Microsoft\Windows\CurrentVersion\Internet Settings`,
+				Filename:        ".juju-proxy",
+				API:             proxyAPI,
+				ExternalUpdate:  config.ExternalUpdate,
+				RunSnapCommands: config.RunSnapCommands,
 			})
 			if err != nil {
 				return nil, errors.Trace(err)
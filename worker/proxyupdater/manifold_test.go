@@ -43,7 +43,8 @@ func (s *ManifoldSuite) SetUpTest(c *gc.C) {
 			}
 			return &dummyWorker{config: cfg}, nil
 		},
-		ExternalUpdate: OtherUpdate,
+		ExternalUpdate:  OtherUpdate,
+		RunSnapCommands: func(string) error { return nil },
 	}
 }
 
@@ -112,6 +113,7 @@ func (s *ManifoldSuite) TestStartSuccess(c *gc.C) {
 	c.Check(dummy.config.API, gc.NotNil)
 	// Checking function equality is problematic.
 	c.Check(dummy.config.ExternalUpdate, gc.NotNil)
+	c.Check(dummy.config.RunSnapCommands, gc.NotNil)
 }
 
 type dummyAgent struct {
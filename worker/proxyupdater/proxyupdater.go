@@ -32,6 +32,11 @@ type Config struct {
 	Filename       string
 	API            API
 	ExternalUpdate func(proxyutils.Settings) error
+
+	// RunSnapCommands, if set, is used to run the shell commands that
+	// configure snapd's proxy settings. If it is nil, snapd is left
+	// alone.
+	RunSnapCommands func(commands string) error
 }
 
 // API is an interface that is provided to New
@@ -141,10 +146,45 @@ func (w *proxyWorker) writeEnvironment() error {
 	case os.Windows:
 		return w.writeEnvironmentToRegistry()
 	default:
+		if err := w.writeSnapProxy(); err != nil {
+			// It isn't really fatal, but we should record it.
+			logger.Errorf("error setting snap proxy: %v", err)
+		}
 		return w.writeEnvironmentFile()
 	}
 }
 
+// RunSnapCommands runs the given shell commands, which configure
+// snapd's proxy settings. It is intended for use as
+// Config.RunSnapCommands.
+func RunSnapCommands(commands string) error {
+	result, err := exec.RunCommands(exec.RunParams{
+		Commands: commands,
+	})
+	if err != nil {
+		return err
+	}
+	if result.Code != 0 {
+		return errors.Errorf("failed setting snap proxy: \n%s\n%s", result.Stdout, result.Stderr)
+	}
+	return nil
+}
+
+// writeSnapProxy configures snapd, if it is installed, to use the
+// model's http and https proxies so that snaps can be installed
+// and refreshed from behind a proxy.
+func (w *proxyWorker) writeSnapProxy() error {
+	if w.config.RunSnapCommands == nil {
+		return nil
+	}
+	return w.config.RunSnapCommands(fmt.Sprintf(
+		`command -v snap >/dev/null || exit 0
+snap set core proxy.http=%s proxy.https=%s`,
+		utils.ShQuote(w.proxy.Http),
+		utils.ShQuote(w.proxy.Https),
+	))
+}
+
 func (w *proxyWorker) handleProxyValues(proxySettings proxyutils.Settings) {
 	proxySettings.SetEnvironmentValues()
 	if proxySettings != w.proxy || w.first {
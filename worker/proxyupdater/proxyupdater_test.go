@@ -242,3 +242,30 @@ func (s *ProxyUpdaterSuite) TestExternalFuncCalled(c *gc.C) {
 
 	c.Assert(externalSettings, jc.DeepEquals, proxySettings)
 }
+
+func (s *ProxyUpdaterSuite) TestSnapProxySet(c *gc.C) {
+	if runtime.GOOS == "windows" {
+		c.Skip("snap proxies are not set on windows")
+	}
+	s.updateConfig(c)
+
+	commands := make(chan string, 1)
+	s.config.RunSnapCommands = func(cmds string) error {
+		select {
+		case commands <- cmds:
+		default:
+		}
+		return nil
+	}
+	updater, err := proxyupdater.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer worker.Stop(updater)
+
+	select {
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("snap proxy not set")
+	case cmds := <-commands:
+		c.Assert(cmds, gc.Equals, `command -v snap >/dev/null || exit 0
+snap set core proxy.http='http proxy' proxy.https='https proxy'`)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pubsubforwarder
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api"
+	apipubsub "github.com/juju/juju/api/pubsub"
+	"github.com/juju/juju/network"
+	"github.com/juju/juju/state"
+)
+
+// NewStateBackend returns a Backend that finds the peers of the
+// controller running on the given machine in the given state. The
+// peers are expected to serve the API on the given port.
+func NewStateBackend(st *state.State, machineId string, apiPort int) Backend {
+	return &stateBackend{
+		st:        st,
+		machineId: machineId,
+		apiPort:   apiPort,
+	}
+}
+
+type stateBackend struct {
+	st        *state.State
+	machineId string
+	apiPort   int
+}
+
+// WatchPeers is part of the Backend interface. The API host ports
+// are updated whenever the controller machines or their addresses
+// change.
+func (b *stateBackend) WatchPeers() state.NotifyWatcher {
+	return b.st.WatchAPIHostPorts()
+}
+
+// PeerAddresses is part of the Backend interface.
+func (b *stateBackend) PeerAddresses() (map[string][]string, error) {
+	info, err := b.st.ControllerInfo()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	peers := make(map[string][]string)
+	for _, id := range info.MachineIds {
+		if id == b.machineId {
+			continue
+		}
+		machine, err := b.st.Machine(id)
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		hostPorts := network.AddressesWithPort(machine.Addresses(), b.apiPort)
+		addrs := network.SelectInternalHostPorts(hostPorts, false)
+		if len(addrs) == 0 {
+			logger.Debugf("controller %q has no usable addresses yet", id)
+			continue
+		}
+		peers[id] = addrs
+	}
+	return peers, nil
+}
+
+// NewMessageWriter is a NewMessageWriterFunc that connects to the
+// API server described by the given info.
+func NewMessageWriter(info *api.Info) (apipubsub.MessageWriter, error) {
+	conn, err := api.Open(info, api.DefaultDialOpts())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	writer, err := apipubsub.NewAPI(conn).MessageWriter()
+	if err != nil {
+		conn.Close()
+		return nil, errors.Trace(err)
+	}
+	return &connWriter{writer, conn}, nil
+}
+
+// connWriter closes the API connection along with the writer.
+type connWriter struct {
+	apipubsub.MessageWriter
+	conn api.Connection
+}
+
+// Close is part of the MessageWriter interface.
+func (w *connWriter) Close() error {
+	err := w.MessageWriter.Close()
+	if closeErr := w.conn.Close(); err == nil {
+		err = closeErr
+	}
+	return errors.Trace(err)
+}
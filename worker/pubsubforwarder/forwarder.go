@@ -0,0 +1,191 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package pubsubforwarder provides a worker that forwards the messages
+// published on a controller's hub to the other controllers in an HA
+// cluster, where the API server republishes them on the local hub.
+package pubsubforwarder
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+
+	"github.com/juju/juju/api"
+	apipubsub "github.com/juju/juju/api/pubsub"
+	"github.com/juju/juju/pubsub"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/catacomb"
+)
+
+var logger = loggo.GetLogger("juju.worker.pubsubforwarder")
+
+// Backend defines the State functionality used by the forwarder.
+type Backend interface {
+	// WatchPeers returns a watcher that notifies when the set of
+	// controller peers or their addresses may have changed.
+	WatchPeers() state.NotifyWatcher
+
+	// PeerAddresses returns the API addresses of the other
+	// controller machines, keyed by machine id.
+	PeerAddresses() (map[string][]string, error)
+}
+
+// NewMessageWriterFunc returns a writer that forwards messages to
+// the API server described by the given info.
+type NewMessageWriterFunc func(info *api.Info) (apipubsub.MessageWriter, error)
+
+// Config holds the dependencies and configuration necessary to run
+// a forwarder.
+type Config struct {
+	Hub              *pubsub.Hub
+	Backend          Backend
+	APIInfo          *api.Info
+	NewMessageWriter NewMessageWriterFunc
+	ErrorDelay       time.Duration
+}
+
+// Validate returns an error if config cannot be expected to drive
+// a functional forwarder.
+func (config Config) Validate() error {
+	if config.Hub == nil {
+		return errors.NotValidf("nil Hub")
+	}
+	if config.Backend == nil {
+		return errors.NotValidf("nil Backend")
+	}
+	if config.APIInfo == nil {
+		return errors.NotValidf("nil APIInfo")
+	}
+	if config.NewMessageWriter == nil {
+		return errors.NotValidf("nil NewMessageWriter")
+	}
+	if config.ErrorDelay <= 0 {
+		return errors.NotValidf("non-positive ErrorDelay")
+	}
+	return nil
+}
+
+// New returns a worker that runs a peer forwarder for each of the
+// other controllers, restarting them as the controllers change.
+func New(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	f := &forwarder{
+		config: config,
+		peers:  make(map[string][]string),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &f.catacomb,
+		Work: f.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return f, nil
+}
+
+type forwarder struct {
+	catacomb catacomb.Catacomb
+	config   Config
+	runner   worker.Runner
+
+	// peers holds the addresses of the peers that have
+	// forwarders running, keyed by machine id.
+	peers map[string][]string
+}
+
+// Kill satisfies the Worker interface.
+func (f *forwarder) Kill() {
+	f.catacomb.Kill(nil)
+}
+
+// Wait satisfies the Worker interface.
+func (f *forwarder) Wait() error {
+	return f.catacomb.Wait()
+}
+
+func (f *forwarder) loop() error {
+	f.runner = worker.NewRunner(
+		neverFatal, neverImportant, f.config.ErrorDelay,
+	)
+	if err := f.catacomb.Add(f.runner); err != nil {
+		return errors.Trace(err)
+	}
+	watcher := f.config.Backend.WatchPeers()
+	if err := f.catacomb.Add(watcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	for {
+		select {
+		case <-f.catacomb.Dying():
+			return f.catacomb.ErrDying()
+		case _, ok := <-watcher.Changes():
+			if !ok {
+				return errors.New("changes stopped")
+			}
+			if err := f.updatePeers(); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// updatePeers starts a forwarder for each new peer, restarts those
+// whose addresses have changed, and stops those that have gone.
+func (f *forwarder) updatePeers() error {
+	peers, err := f.config.Backend.PeerAddresses()
+	if err != nil {
+		return errors.Annotate(err, "cannot get controller peers")
+	}
+	for id := range f.peers {
+		if _, ok := peers[id]; !ok {
+			logger.Debugf("stopping forwarding to controller %q", id)
+			if err := f.runner.StopWorker(id); err != nil {
+				return errors.Trace(err)
+			}
+			delete(f.peers, id)
+		}
+	}
+	for id, addrs := range peers {
+		if current, ok := f.peers[id]; ok {
+			if reflect.DeepEqual(current, addrs) {
+				continue
+			}
+			if err := f.runner.StopWorker(id); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		logger.Debugf("forwarding messages to controller %q at %v", id, addrs)
+		if err := f.runner.StartWorker(id, f.starter(addrs)); err != nil {
+			return errors.Trace(err)
+		}
+		f.peers[id] = addrs
+	}
+	return nil
+}
+
+func (f *forwarder) starter(addrs []string) func() (worker.Worker, error) {
+	return func() (worker.Worker, error) {
+		info := *f.config.APIInfo
+		info.Addrs = addrs
+		writer, err := f.config.NewMessageWriter(&info)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return newPeerForwarder(f.config.Hub, writer)
+	}
+}
+
+func neverFatal(error) bool {
+	return false
+}
+
+func neverImportant(error, error) bool {
+	return false
+}
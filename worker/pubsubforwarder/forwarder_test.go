@@ -0,0 +1,298 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pubsubforwarder_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api"
+	apipubsub "github.com/juju/juju/api/pubsub"
+	"github.com/juju/juju/pubsub"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/pubsubforwarder"
+	"github.com/juju/juju/worker/workertest"
+)
+
+type forwarderSuite struct {
+	coretesting.BaseSuite
+
+	hub     *pubsub.Hub
+	backend *mockBackend
+	writers chan *mockWriter
+	config  pubsubforwarder.Config
+}
+
+var _ = gc.Suite(&forwarderSuite{})
+
+func (s *forwarderSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.hub = pubsub.NewHub("machine-0")
+	s.backend = &mockBackend{
+		changes: make(chan struct{}, 1),
+		peers: map[string][]string{
+			"1": {"10.0.0.1:17070"},
+		},
+	}
+	s.writers = make(chan *mockWriter, 10)
+	s.config = pubsubforwarder.Config{
+		Hub:     s.hub,
+		Backend: s.backend,
+		APIInfo: &api.Info{Password: "sekrit"},
+		NewMessageWriter: func(info *api.Info) (apipubsub.MessageWriter, error) {
+			c.Check(info.Password, gc.Equals, "sekrit")
+			w := &mockWriter{
+				addrs:    info.Addrs,
+				messages: make(chan pubsub.Message, 10),
+				closed:   make(chan struct{}),
+			}
+			s.writers <- w
+			return w, nil
+		},
+		ErrorDelay: time.Millisecond,
+	}
+}
+
+func (s *forwarderSuite) TestValidate(c *gc.C) {
+	for i, test := range []struct {
+		modify func(*pubsubforwarder.Config)
+		err    string
+	}{{
+		func(config *pubsubforwarder.Config) { config.Hub = nil },
+		"nil Hub not valid",
+	}, {
+		func(config *pubsubforwarder.Config) { config.Backend = nil },
+		"nil Backend not valid",
+	}, {
+		func(config *pubsubforwarder.Config) { config.APIInfo = nil },
+		"nil APIInfo not valid",
+	}, {
+		func(config *pubsubforwarder.Config) { config.NewMessageWriter = nil },
+		"nil NewMessageWriter not valid",
+	}, {
+		func(config *pubsubforwarder.Config) { config.ErrorDelay = 0 },
+		"non-positive ErrorDelay not valid",
+	}} {
+		c.Logf("test %d", i)
+		config := s.config
+		test.modify(&config)
+		w, err := pubsubforwarder.New(config)
+		c.Check(err, jc.Satisfies, errors.IsNotValid)
+		c.Check(err, gc.ErrorMatches, test.err)
+		c.Check(w, gc.IsNil)
+	}
+}
+
+func (s *forwarderSuite) TestForwardsLocalMessages(c *gc.C) {
+	w := s.startForwarder(c)
+	defer workertest.CleanKill(c, w)
+	writer := s.nextWriter(c)
+	c.Assert(writer.addrs, jc.DeepEquals, []string{"10.0.0.1:17070"})
+
+	s.waitSubscribed(c, writer)
+
+	s.hub.PublishMessage(pubsub.Message{Topic: "topic", Origin: "machine-1"})
+	s.hub.Publish("topic", map[string]interface{}{"key": "value"})
+	c.Assert(writer.nextMessage(c), jc.DeepEquals, pubsub.Message{
+		Topic:  "topic",
+		Origin: "machine-0",
+		Data:   map[string]interface{}{"key": "value"},
+	})
+	writer.assertNoMessage(c)
+}
+
+func (s *forwarderSuite) TestStopsForwardingToRemovedPeers(c *gc.C) {
+	w := s.startForwarder(c)
+	defer workertest.CleanKill(c, w)
+	writer := s.nextWriter(c)
+
+	s.backend.setPeers(map[string][]string{})
+	writer.waitClosed(c)
+	s.assertNoWriter(c)
+}
+
+func (s *forwarderSuite) TestReconnectsWhenPeerAddressesChange(c *gc.C) {
+	w := s.startForwarder(c)
+	defer workertest.CleanKill(c, w)
+	writer := s.nextWriter(c)
+
+	s.backend.setPeers(map[string][]string{
+		"1": {"10.0.0.2:17070"},
+	})
+	writer.waitClosed(c)
+	writer = s.nextWriter(c)
+	c.Assert(writer.addrs, jc.DeepEquals, []string{"10.0.0.2:17070"})
+}
+
+func (s *forwarderSuite) TestReconnectsAfterWriteError(c *gc.C) {
+	w := s.startForwarder(c)
+	defer workertest.CleanKill(c, w)
+	writer := s.nextWriter(c)
+	s.waitSubscribed(c, writer)
+	writer.setError(errors.New("boom"))
+
+	s.hub.Publish("topic", nil)
+	writer.waitClosed(c)
+
+	writer = s.nextWriter(c)
+	s.waitSubscribed(c, writer)
+}
+
+func (s *forwarderSuite) startForwarder(c *gc.C) worker.Worker {
+	w, err := pubsubforwarder.New(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	s.backend.changes <- struct{}{}
+	return w
+}
+
+// waitSubscribed publishes sync messages until one is forwarded
+// to the given writer, showing that its forwarder is subscribed.
+func (s *forwarderSuite) waitSubscribed(c *gc.C, writer *mockWriter) {
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		s.hub.Publish("sync", nil)
+		select {
+		case m := <-writer.messages:
+			c.Assert(m.Topic, gc.Equals, "sync")
+			// Drain any other sync messages already sent.
+			for {
+				select {
+				case <-writer.messages:
+				case <-time.After(coretesting.ShortWait):
+					return
+				}
+			}
+		case <-time.After(coretesting.ShortWait):
+		}
+	}
+	c.Fatalf("timed out waiting for forwarder to subscribe")
+}
+
+func (s *forwarderSuite) nextWriter(c *gc.C) *mockWriter {
+	select {
+	case w := <-s.writers:
+		return w
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for connection")
+	}
+	panic("unreachable")
+}
+
+func (s *forwarderSuite) assertNoWriter(c *gc.C) {
+	select {
+	case w := <-s.writers:
+		c.Fatalf("unexpected connection to %v", w.addrs)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
+
+type mockBackend struct {
+	changes chan struct{}
+
+	mu    sync.Mutex
+	peers map[string][]string
+}
+
+func (b *mockBackend) WatchPeers() state.NotifyWatcher {
+	return &mockNotifyWatcher{
+		Worker:  workertest.NewErrorWorker(nil),
+		changes: b.changes,
+	}
+}
+
+func (b *mockBackend) PeerAddresses() (map[string][]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	peers := make(map[string][]string)
+	for id, addrs := range b.peers {
+		peers[id] = addrs
+	}
+	return peers, nil
+}
+
+func (b *mockBackend) setPeers(peers map[string][]string) {
+	b.mu.Lock()
+	b.peers = peers
+	b.mu.Unlock()
+	b.changes <- struct{}{}
+}
+
+type mockNotifyWatcher struct {
+	worker.Worker
+	changes chan struct{}
+}
+
+func (w *mockNotifyWatcher) Changes() <-chan struct{} {
+	return w.changes
+}
+
+func (w *mockNotifyWatcher) Stop() error {
+	w.Kill()
+	return w.Wait()
+}
+
+func (w *mockNotifyWatcher) Err() error {
+	return nil
+}
+
+type mockWriter struct {
+	addrs    []string
+	messages chan pubsub.Message
+	closed   chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+func (w *mockWriter) ForwardMessage(m *pubsub.Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.err != nil {
+		return w.err
+	}
+	w.messages <- *m
+	return nil
+}
+
+func (w *mockWriter) Close() error {
+	close(w.closed)
+	return nil
+}
+
+func (w *mockWriter) setError(err error) {
+	w.mu.Lock()
+	w.err = err
+	w.mu.Unlock()
+}
+
+func (w *mockWriter) nextMessage(c *gc.C) pubsub.Message {
+	select {
+	case m := <-w.messages:
+		return m
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for message")
+	}
+	panic("unreachable")
+}
+
+func (w *mockWriter) assertNoMessage(c *gc.C) {
+	select {
+	case m := <-w.messages:
+		c.Fatalf("unexpected message %#v", m)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
+
+func (w *mockWriter) waitClosed(c *gc.C) {
+	select {
+	case <-w.closed:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for connection to close")
+	}
+}
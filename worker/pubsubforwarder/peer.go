@@ -0,0 +1,72 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pubsubforwarder
+
+import (
+	"github.com/juju/errors"
+
+	apipubsub "github.com/juju/juju/api/pubsub"
+	"github.com/juju/juju/pubsub"
+	"github.com/juju/juju/worker/catacomb"
+)
+
+// peerForwarder sends the messages that originate on the local hub
+// to a single peer. Messages published while the peer cannot be
+// reached are not forwarded; the worker fails on the first write
+// error so that the forwarder's runner reconnects after a delay.
+type peerForwarder struct {
+	catacomb catacomb.Catacomb
+	hub      *pubsub.Hub
+	writer   apipubsub.MessageWriter
+}
+
+func newPeerForwarder(hub *pubsub.Hub, writer apipubsub.MessageWriter) (*peerForwarder, error) {
+	p := &peerForwarder{
+		hub:    hub,
+		writer: writer,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &p.catacomb,
+		Work: p.loop,
+	})
+	if err != nil {
+		writer.Close()
+		return nil, errors.Trace(err)
+	}
+	return p, nil
+}
+
+// Kill satisfies the Worker interface.
+func (p *peerForwarder) Kill() {
+	p.catacomb.Kill(nil)
+}
+
+// Wait satisfies the Worker interface.
+func (p *peerForwarder) Wait() error {
+	return p.catacomb.Wait()
+}
+
+func (p *peerForwarder) loop() error {
+	defer p.writer.Close()
+	unsubscribe := p.hub.Subscribe("", p.forward)
+	defer unsubscribe()
+	<-p.catacomb.Dying()
+	return p.catacomb.ErrDying()
+}
+
+func (p *peerForwarder) forward(m pubsub.Message) {
+	if m.Origin != p.hub.Origin() {
+		// Messages forwarded to us are already
+		// being forwarded by their origin.
+		return
+	}
+	select {
+	case <-p.catacomb.Dying():
+		return
+	default:
+	}
+	if err := p.writer.ForwardMessage(&m); err != nil {
+		p.catacomb.Kill(errors.Trace(err))
+	}
+}
@@ -35,6 +35,10 @@ func (c *Config) Validate() error {
 	if c.NewTimer == nil {
 		return errors.New("missing Timer")
 	}
+	if c.PruneInterval <= 0 {
+		// A zero interval would prune the database continuously.
+		return errors.Errorf("invalid PruneInterval %v", c.PruneInterval)
+	}
 	// TODO(perrito666) this assumes out of band knowledge of how filter
 	// values are treated, expand config to support the "dont use this filter"
 	// case as an explicit statement.
@@ -97,6 +97,49 @@ func (s *statusHistoryPrunerSuite) TestWorkerWontCallPruneBeforeFiringTimer(c *g
 	}
 }
 
+func (s *statusHistoryPrunerSuite) TestValidate(c *gc.C) {
+	validConfig := func() statushistorypruner.Config {
+		return statushistorypruner.Config{
+			Facade:         newFakeFacade(),
+			MaxHistoryTime: time.Second,
+			MaxHistoryMB:   3,
+			PruneInterval:  time.Minute,
+			NewTimer:       worker.NewTimer,
+		}
+	}
+	c.Check(validConfig().Validate(), jc.ErrorIsNil)
+
+	for i, test := range []struct {
+		mutate func(*statushistorypruner.Config)
+		err    string
+	}{{
+		mutate: func(conf *statushistorypruner.Config) { conf.Facade = nil },
+		err:    "missing Facade",
+	}, {
+		mutate: func(conf *statushistorypruner.Config) { conf.NewTimer = nil },
+		err:    "missing Timer",
+	}, {
+		mutate: func(conf *statushistorypruner.Config) { conf.PruneInterval = 0 },
+		err:    "invalid PruneInterval 0s",
+	}, {
+		mutate: func(conf *statushistorypruner.Config) { conf.PruneInterval = -time.Second },
+		err:    "invalid PruneInterval -1s",
+	}, {
+		mutate: func(conf *statushistorypruner.Config) {
+			conf.MaxHistoryTime = 0
+			conf.MaxHistoryMB = 0
+		},
+		err: "missing prune criteria, no size or date limit provided",
+	}} {
+		c.Logf("test %d: %s", i, test.err)
+		conf := validConfig()
+		test.mutate(&conf)
+		c.Check(conf.Validate(), gc.ErrorMatches, test.err)
+		_, err := statushistorypruner.New(conf)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
 type mockTimer struct {
 	period chan time.Duration
 	c      chan time.Time
@@ -440,7 +440,7 @@ func setFilesystemAttachmentInfo(ctx *context, filesystemAttachments []storage.F
 		filesystemAttachmentsFromStorage(filesystemAttachments),
 	)
 	if err != nil {
-		return errors.Annotate(err, "publishing filesystems to state")
+		return errors.Annotate(err, "publishing filesystem attachments to state")
 	}
 	for i, result := range errorResults {
 		if result.Error != nil {
@@ -1142,6 +1142,47 @@ func (s *storageProvisionerSuite) TestSetVolumeInfoErrorStopsWorker(c *gc.C) {
 	waitChannel(c, done, "waiting for worker to exit")
 }
 
+func (s *storageProvisionerSuite) TestSetVolumeAttachmentInfoErrorStopsWorker(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedMachines["machine-1"] = instance.Id("already-provisioned-1")
+	volumeAccessor.setVolumeAttachmentInfo = func(volumeAttachments []params.VolumeAttachment) ([]params.ErrorResult, error) {
+		return nil, errors.New("belly up")
+	}
+
+	s.provider.createVolumesFunc = func(args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+		return []storage.CreateVolumesResult{{
+			Volume: &storage.Volume{
+				Tag: args[0].Tag,
+				VolumeInfo: storage.VolumeInfo{
+					VolumeId: "vol-ume",
+				},
+			},
+			VolumeAttachment: &storage.VolumeAttachment{
+				Volume:  args[0].Attachment.Volume,
+				Machine: args[0].Attachment.Machine,
+			},
+		}}, nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer worker.Wait()
+	defer worker.Kill()
+
+	done := make(chan interface{})
+	go func() {
+		defer close(done)
+		err := worker.Wait()
+		c.Assert(err, gc.ErrorMatches, "creating volumes: publishing volume attachments to state: belly up")
+	}()
+
+	volumeAccessor.attachmentsWatcher.changes <- []watcher.MachineStorageId{{
+		MachineTag: "machine-1", AttachmentTag: "volume-1",
+	}}
+	volumeAccessor.volumesWatcher.changes <- []string{"1"}
+	waitChannel(c, done, "waiting for worker to exit")
+}
+
 func (s *storageProvisionerSuite) TestSetVolumeInfoErrorResultDoesNotStopWorker(c *gc.C) {
 	volumeAccessor := newMockVolumeAccessor()
 	volumeAccessor.provisionedMachines["machine-1"] = instance.Id("already-provisioned-1")
@@ -419,7 +419,7 @@ func setVolumeAttachmentInfo(ctx *context, volumeAttachments []storage.VolumeAtt
 		volumeAttachmentsFromStorage(volumeAttachments),
 	)
 	if err != nil {
-		return errors.Annotate(err, "publishing volumes to state")
+		return errors.Annotate(err, "publishing volume attachments to state")
 	}
 	for i, result := range errorResults {
 		if result.Error != nil {
@@ -4,6 +4,8 @@
 package undertaker
 
 import (
+	"fmt"
+
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/apiserver/params"
@@ -144,6 +146,14 @@ func (u *Undertaker) setStatus(modelStatus status.Status, message string) error
 	return u.config.Facade.SetStatus(modelStatus, message, nil)
 }
 
+// processDyingModel waits for the model's resources to be removed, and
+// sets the model to Dead once they have been.
+//
+// TODO: there is no timeout after which a model that never empties is
+// forcibly destroyed. That needs a clock here, and a way for the facade
+// to set a model with remaining machines and applications to Dead,
+// which state does not yet support. Until then a stuck model stays
+// Dying, and its status reports why.
 func (u *Undertaker) processDyingModel() error {
 	watcher, err := u.config.Facade.WatchModelResources()
 	if err != nil {
@@ -154,6 +164,7 @@ func (u *Undertaker) processDyingModel() error {
 	}
 	defer watcher.Kill() // The watcher is not needed once this func returns.
 
+	var lastMessage string
 	for {
 		select {
 		case <-u.catacomb.Dying():
@@ -173,6 +184,16 @@ func (u *Undertaker) processDyingModel() error {
 				return nil
 			}
 			// Yes, we ignore the error. See comment above.
+			// We do report it, though, so that it's clear
+			// why the model has not yet been removed.
+			message := fmt.Sprintf("cleaning up cloud resources: %v", err)
+			if message == lastMessage {
+				continue
+			}
+			if err := u.setStatus(status.Destroying, message); err != nil {
+				return errors.Trace(err)
+			}
+			lastMessage = message
 		}
 	}
 }
@@ -116,15 +116,18 @@ func (s *UndertakerSuite) TestWatchModelResourcesErrorFatal(c *gc.C) {
 
 func (s *UndertakerSuite) TestProcessDyingModelErrorRetried(c *gc.C) {
 	s.fix.errors = []error{
-		nil, // ModelInfo
-		nil, // SetStatus
-		nil, // WatchModelResources,
+		nil,                            // ModelInfo
+		nil,                            // SetStatus
+		nil,                            // WatchModelResources,
 		errors.New("meh, will retry"),  // ProcessDyingModel,
+		nil,                            // SetStatus
 		errors.New("will retry again"), // ProcessDyingModel,
-		nil, // ProcessDyingModel,
-		nil, // SetStatus
-		nil, // Destroy,
-		nil, // RemoveModel
+		nil,                            // SetStatus
+		errors.New("will retry again"), // ProcessDyingModel,
+		nil,                            // ProcessDyingModel,
+		nil,                            // SetStatus
+		nil,                            // Destroy,
+		nil,                            // RemoveModel
 	}
 	stub := s.fix.run(c, func(w worker.Worker) {
 		workertest.CheckKilled(c, w)
@@ -134,12 +137,23 @@ func (s *UndertakerSuite) TestProcessDyingModelErrorRetried(c *gc.C) {
 		"SetStatus",
 		"WatchModelResources",
 		"ProcessDyingModel",
+		"SetStatus",
+		"ProcessDyingModel",
+		"SetStatus",
 		"ProcessDyingModel",
 		"ProcessDyingModel",
 		"SetStatus",
 		"Destroy",
 		"RemoveModel",
 	)
+	stub.CheckCall(
+		c, 4, "SetStatus", status.Destroying,
+		"cleaning up cloud resources: meh, will retry", map[string]interface{}(nil),
+	)
+	stub.CheckCall(
+		c, 6, "SetStatus", status.Destroying,
+		"cleaning up cloud resources: will retry again", map[string]interface{}(nil),
+	)
 }
 
 func (s *UndertakerSuite) TestDestroyErrorFatal(c *gc.C) {
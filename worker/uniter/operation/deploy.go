@@ -75,7 +75,7 @@ func (d *deploy) Prepare(state State) (*State, error) {
 // recorded in the supplied state.
 // Execute is part of the Operation interface.
 func (d *deploy) Execute(state State) (*State, error) {
-	if err := d.deployer.Deploy(); err == charm.ErrConflict {
+	if err := d.deployer.Deploy(); errors.Cause(err) == charm.ErrConflict {
 		return nil, NewDeployConflictError(d.charmURL)
 	} else if err != nil {
 		return nil, errors.Trace(err)
@@ -309,7 +309,7 @@ func (s *DeploySuite) testExecuteConflictError(c *gc.C, newDeploy newDeploy) {
 		MockNotifyRevert:   &MockNoArgs{},
 		MockNotifyResolved: &MockNoArgs{},
 		MockStage:          &MockStage{},
-		MockDeploy:         &MockNoArgs{err: charm.ErrConflict},
+		MockDeploy:         &MockNoArgs{err: errors.Annotate(charm.ErrConflict, "overwriting")},
 	}
 	factory := operation.NewFactory(operation.FactoryParams{
 		Deployer:  deployer,
@@ -330,19 +330,19 @@ func (s *DeploySuite) testExecuteConflictError(c *gc.C, newDeploy newDeploy) {
 }
 
 func (s *DeploySuite) TestExecuteConflictError_Install(c *gc.C) {
-	s.testExecuteError(c, (operation.Factory).NewInstall)
+	s.testExecuteConflictError(c, (operation.Factory).NewInstall)
 }
 
 func (s *DeploySuite) TestExecuteConflictError_Upgrade(c *gc.C) {
-	s.testExecuteError(c, (operation.Factory).NewUpgrade)
+	s.testExecuteConflictError(c, (operation.Factory).NewUpgrade)
 }
 
 func (s *DeploySuite) TestExecuteConflictError_RevertUpgrade(c *gc.C) {
-	s.testExecuteError(c, (operation.Factory).NewRevertUpgrade)
+	s.testExecuteConflictError(c, (operation.Factory).NewRevertUpgrade)
 }
 
 func (s *DeploySuite) TestExecuteConflictError_ResolvedUpgrade(c *gc.C) {
-	s.testExecuteError(c, (operation.Factory).NewResolvedUpgrade)
+	s.testExecuteConflictError(c, (operation.Factory).NewResolvedUpgrade)
 }
 
 func (s *DeploySuite) testExecuteError(c *gc.C, newDeploy newDeploy) {
@@ -195,6 +195,9 @@ func (f *StateFile) Read() (*State, error) {
 		if os.IsNotExist(err) {
 			return nil, ErrNoStateFile
 		}
+		// A corrupt state file must not be mistaken for an empty
+		// one, or an interrupted operation would be forgotten.
+		return nil, errors.Annotatef(err, "cannot read %q", f.path)
 	}
 	if err := st.validate(); err != nil {
 		return nil, errors.Errorf("cannot read %q: %v", f.path, err)
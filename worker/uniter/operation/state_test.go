@@ -4,6 +4,7 @@
 package operation_test
 
 import (
+	"io/ioutil"
 	"path/filepath"
 
 	jc "github.com/juju/testing/checkers"
@@ -215,3 +216,13 @@ func (s *StateFileSuite) TestStates(c *gc.C) {
 		c.Assert(st, jc.DeepEquals, &t.st)
 	}
 }
+
+func (s *StateFileSuite) TestCorruptStateFile(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "uniter")
+	err := ioutil.WriteFile(path, []byte("op: [run-hook"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+	file := operation.NewStateFile(path)
+	_, err = file.Read()
+	c.Assert(err, gc.ErrorMatches, `cannot read ".*": .*`)
+	c.Assert(err, gc.Not(gc.Equals), operation.ErrNoStateFile)
+}
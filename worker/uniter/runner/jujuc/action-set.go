@@ -65,6 +65,11 @@ func (c *ActionSetCommand) SetFlags(f *gnuflag.FlagSet) {
 
 // Init accepts maps in the form of key=value, key.key2.keyN....=value
 func (c *ActionSetCommand) Init(args []string) error {
+	if len(args) == 0 {
+		// Charms in the wild call action-set with no arguments,
+		// so this is not an error; it just does nothing.
+		logger.Warningf("action-set called with no key=value pairs")
+	}
 	c.args = make([][]string, 0)
 	for _, arg := range args {
 		thisArg := strings.SplitN(arg, "=", 2)
@@ -62,6 +62,9 @@ func (s *ActionSetSuite) TestActionSet(c *gc.C) {
 		errMsg   string
 		code     int
 	}{{
+		summary: "no arguments are accepted and set nothing",
+		command: []string{},
+	}, {
 		summary: "bare value(s) are an Init error",
 		command: []string{"result"},
 		errMsg:  "error: argument \"result\" must be of the form key...=value\n",
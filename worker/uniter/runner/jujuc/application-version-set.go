@@ -4,6 +4,8 @@
 package jujuc
 
 import (
+	"strings"
+
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
 )
@@ -30,6 +32,9 @@ other useful identifier, such as a Git hash, that indicates the
 version of the deployed software. (It shouldn't be confused with the
 charm revision.) The version set will be displayed in "juju status"
 output for the application.
+
+Leading and trailing whitespace is removed from the version, so the
+output of a command may be passed directly.
 `
 	return &cmd.Info{
 		Name:    "application-version-set",
@@ -44,7 +49,7 @@ func (c *applicationVersionSetCommand) Init(args []string) error {
 	if len(args) < 1 {
 		return errors.New("no version specified")
 	}
-	c.version = args[0]
+	c.version = strings.TrimSpace(args[0])
 	return cmd.CheckEmpty(args[1:])
 }
 
@@ -48,6 +48,15 @@ func (s *ApplicationVersionSetSuite) TestApplicationVersionSetWithArguments(c *g
 	c.Check(hctx.info.Version.WorkloadVersion, gc.Equals, "dia de los muertos")
 }
 
+func (s *ApplicationVersionSetSuite) TestApplicationVersionSetTrimsWhitespace(c *gc.C) {
+	hctx, com := s.createCommand(c, nil)
+	ctx := testing.Context(c)
+	code := cmd.Main(com, ctx, []string{"9.5.4\n"})
+	c.Check(code, gc.Equals, 0)
+	c.Check(bufferString(ctx.Stderr), gc.Equals, "")
+	c.Check(hctx.info.Version.WorkloadVersion, gc.Equals, "9.5.4")
+}
+
 func (s *ApplicationVersionSetSuite) TestApplicationVersionSetError(c *gc.C) {
 	hctx, com := s.createCommand(c, errors.New("uh oh spaghettio"))
 	ctx := testing.Context(c)
@@ -73,6 +82,9 @@ other useful identifier, such as a Git hash, that indicates the
 version of the deployed software. (It shouldn't be confused with the
 charm revision.) The version set will be displayed in "juju status"
 output for the application.
+
+Leading and trailing whitespace is removed from the version, so the
+output of a command may be passed directly.
 `[1:]
 
 	_, com := s.createCommand(c, nil)
@@ -9,6 +9,7 @@ import (
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/apiserver/params"
 )
@@ -91,6 +92,9 @@ func (c *RelationGetCommand) Init(args []string) error {
 	if c.UnitName == "" {
 		return fmt.Errorf("no unit id specified")
 	}
+	if !names.IsValidUnit(c.UnitName) {
+		return fmt.Errorf("invalid unit id %q", c.UnitName)
+	}
 	return cmd.CheckEmpty(args)
 }
 
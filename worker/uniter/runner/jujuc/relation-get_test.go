@@ -63,6 +63,12 @@ var relationGetTests = []struct {
 		code:    2,
 		args:    []string{"-r", "burble:1"},
 		out:     `no unit id specified`,
+	}, {
+		summary: "explicit relation, invalid unit",
+		relid:   -1,
+		code:    2,
+		args:    []string{"-r", "burble:1", "-", "bad"},
+		out:     `invalid unit id "bad"`,
 	}, {
 		summary: "missing key",
 		relid:   1,
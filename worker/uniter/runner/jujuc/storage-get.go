@@ -32,7 +32,12 @@ func NewStorageGetCommand(ctx Context) (cmd.Command, error) {
 
 func (c *StorageGetCommand) Info() *cmd.Info {
 	doc := `
-When no <key> is supplied, all keys values are printed.
+When no <key> is supplied, all keys values are printed. The available
+keys are "kind" (block or filesystem) and "location" (the path of the
+block device or the filesystem mount point).
+
+Within a storage hook, the storage instance defaults to the one that
+the hook is running for; otherwise it must be specified with -s.
 `
 	return &cmd.Info{
 		Name:    "storage-get",
@@ -82,7 +82,12 @@ Options:
     specify a storage instance by id
 
 Details:
-When no <key> is supplied, all keys values are printed.
+When no <key> is supplied, all keys values are printed. The available
+keys are "kind" (block or filesystem) and "location" (the path of the
+block device or the filesystem mount point).
+
+Within a storage hook, the storage instance defaults to the one that
+the hook is running for; otherwise it must be specified with -s.
 `)
 	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
 }
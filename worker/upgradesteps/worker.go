@@ -67,7 +67,8 @@ func NewLock(a agent.Agent) (gate.Lock, error) {
 	}
 
 	err := a.ChangeConfig(func(agentConfig agent.ConfigSetter) error {
-		if !upgrades.AreUpgradesDefined(agentConfig.UpgradedToVersion()) {
+		_, isController := agentConfig.StateServingInfo()
+		if !upgrades.AreUpgradesDefined(agentConfig.UpgradedToVersion(), isController) {
 			logger.Infof("no upgrade steps required or upgrade steps for %v "+
 				"have already been run.", jujuversion.Current)
 			lock.Unlock()
@@ -17,6 +17,7 @@ import (
 	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/agent"
+	"github.com/juju/juju/apiserver/params"
 	cmdutil "github.com/juju/juju/cmd/jujud/util"
 	"github.com/juju/juju/constraints"
 	"github.com/juju/juju/environs"
@@ -581,14 +582,19 @@ func NewFakeConfigSetter(agentTag names.Tag, initialVersion version.Number) *fak
 
 type fakeConfigSetter struct {
 	agent.ConfigSetter
-	AgentTag names.Tag
-	Version  version.Number
+	AgentTag   names.Tag
+	Version    version.Number
+	Controller bool
 }
 
 func (s *fakeConfigSetter) Tag() names.Tag {
 	return s.AgentTag
 }
 
+func (s *fakeConfigSetter) StateServingInfo() (params.StateServingInfo, bool) {
+	return params.StateServingInfo{}, s.Controller
+}
+
 func (s *fakeConfigSetter) UpgradedToVersion() version.Number {
 	return s.Version
 }